@@ -0,0 +1,94 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"errors"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// AcyclicSP solves the single-source shortest-paths problem in edge-weighted
+// directed acyclic graphs (DAGs). Edge weights can be negative.
+type AcyclicSP struct {
+	spt
+}
+
+// NewAcyclicSP computes a shortest-paths tree from source vertex s to every
+// other vertex in g by relaxing the vertices in topological order. It takes
+// O(E + V) time. It returns an error if g has a directed cycle.
+func NewAcyclicSP(g datastructs.EdgeWeightedDigraph, s int) (*AcyclicSP, error) {
+	validateVertex(s, g.V)
+	order, ok := edgeWeightedTopologicalOrder(g)
+	if !ok {
+		return nil, errors.New("digraph is not acyclic")
+	}
+
+	sp := &AcyclicSP{spt: newSPT(g.V, s)}
+	for _, v := range order {
+		for i := range g.Adj[v] {
+			e := &g.Adj[v][i]
+			if sp.distTo[e.To] > sp.distTo[v]+e.Weight {
+				sp.distTo[e.To] = sp.distTo[v] + e.Weight
+				sp.edgeTo[e.To] = e
+			}
+		}
+	}
+	return sp, nil
+}
+
+// edgeWeightedTopologicalOrder returns the vertices of g in topological order,
+// computed as the reverse postorder of a depth-first search. The second return
+// value is false if g has a directed cycle, in which case there is no
+// topological order.
+func edgeWeightedTopologicalOrder(g datastructs.EdgeWeightedDigraph) ([]int, bool) {
+	marked := make([]bool, g.V)
+	onStack := make([]bool, g.V)
+	reversePost := datastructs.Stack[int]{}
+	hasCycle := false
+
+	var visit func(v int)
+	visit = func(v int) {
+		marked[v] = true
+		onStack[v] = true
+		for _, e := range g.Adj[v] {
+			if hasCycle {
+				return
+			}
+			if !marked[e.To] {
+				visit(e.To)
+			} else if onStack[e.To] {
+				hasCycle = true
+			}
+		}
+		onStack[v] = false
+		reversePost.Push(v)
+	}
+
+	for v := 0; v < g.V && !hasCycle; v++ {
+		if !marked[v] {
+			visit(v)
+		}
+	}
+	if hasCycle {
+		return nil, false
+	}
+	order := make([]int, 0, g.V)
+	for !reversePost.IsEmpty() {
+		order = append(order, reversePost.Pop())
+	}
+	return order, true
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyEWDAG returns the edge-weighted DAG from tinyEWDAG.txt in algs4.
+func tinyEWDAG() datastructs.EdgeWeightedDigraph {
+	return edgeWeightedDigraph(8, []datastructs.DirectedEdge{
+		{From: 5, To: 4, Weight: 0.35},
+		{From: 4, To: 7, Weight: 0.37},
+		{From: 5, To: 7, Weight: 0.28},
+		{From: 5, To: 1, Weight: 0.32},
+		{From: 4, To: 0, Weight: 0.38},
+		{From: 0, To: 2, Weight: 0.26},
+		{From: 3, To: 7, Weight: 0.39},
+		{From: 1, To: 3, Weight: 0.29},
+		{From: 7, To: 2, Weight: 0.34},
+		{From: 6, To: 2, Weight: 0.40},
+		{From: 3, To: 6, Weight: 0.52},
+		{From: 6, To: 0, Weight: 0.58},
+		{From: 6, To: 4, Weight: 0.93},
+	})
+}
+
+func TestAcyclicSP(t *testing.T) {
+	sp, err := NewAcyclicSP(tinyEWDAG(), 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	testCases := []struct {
+		name string
+		v    int
+		dist float64
+	}{
+		{"0", 0, 0.73},
+		{"1", 1, 0.32},
+		{"2", 2, 0.62},
+		{"3", 3, 0.61},
+		{"4", 4, 0.35},
+		{"5", 5, 0.00},
+		{"6", 6, 1.13},
+		{"7", 7, 0.28},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if math.Abs(sp.DistTo(tc.v)-tc.dist) > 1e-9 {
+				t.Errorf("expected %v; got %v", tc.dist, sp.DistTo(tc.v))
+			}
+			checkPath(t, sp, 5, tc.v)
+		})
+	}
+}
+
+func TestAcyclicSPNegativeWeights(t *testing.T) {
+	// longest paths in a DAG are shortest paths with negated weights
+	dag := tinyEWDAG()
+	g := datastructs.CreateEdgeWeightedDigraph(dag.V)
+	for _, e := range dag.Edges() {
+		g.AddEdge(datastructs.DirectedEdge{From: e.From, To: e.To, Weight: -e.Weight})
+	}
+	sp, err := NewAcyclicSP(g, 5)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// the longest path from 5 to 2 is 5->1->3->6->4->7->2
+	if math.Abs(sp.DistTo(2)+2.77) > 1e-9 {
+		t.Errorf("expected %v; got %v", -2.77, sp.DistTo(2))
+	}
+	if len(sp.PathTo(2)) != 6 {
+		t.Errorf("expected %v; got %v", 6, len(sp.PathTo(2)))
+	}
+}
+
+func TestAcyclicSPCycle(t *testing.T) {
+	_, err := NewAcyclicSP(tinyEWD(), 0)
+	if err == nil {
+		t.Errorf("expected an error for a digraph with a cycle")
+	}
+}
+
+func ExampleAcyclicSP() {
+	sp, err := NewAcyclicSP(tinyEWDAG(), 5)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, e := range sp.PathTo(6) {
+		fmt.Println(e)
+	}
+	// Output:
+	// 5->1 0.32000
+	// 1->3 0.29000
+	// 3->6 0.52000
+}
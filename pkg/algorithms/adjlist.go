@@ -0,0 +1,88 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// adjList is a graph stored as plain adjacency lists. Algorithms that need a
+// derived graph, such as the reverse of a digraph, build one of these rather
+// than assume a concrete type for the graph they were given.
+type adjList [][]int
+
+// NumVertices returns the number of vertices in the graph.
+func (a adjList) NumVertices() int {
+	return len(a)
+}
+
+// Adjacent returns the vertices adjacent to vertex v.
+func (a adjList) Adjacent(v int) []int {
+	return a[v]
+}
+
+// reverse returns the reverse of g, with every edge v->w replaced by w->v.
+func reverse(g datastructs.Adjacency) adjList {
+	r := make(adjList, g.NumVertices())
+	for v := 0; v < g.NumVertices(); v++ {
+		for _, w := range g.Adjacent(v) {
+			r[w] = append(r[w], v)
+		}
+	}
+	return r
+}
+
+// undirectedList is an adjList whose adjacency lists are symmetric.
+type undirectedList struct {
+	adjList
+}
+
+// Undirected marks undirectedList as satisfying datastructs.Undirected.
+func (u undirectedList) Undirected() {}
+
+// undirected returns g with the direction of its edges ignored: every edge
+// v->w is stored in both adjacency lists.
+func undirected(g datastructs.Adjacency) undirectedList {
+	u := make(adjList, g.NumVertices())
+	for v := 0; v < g.NumVertices(); v++ {
+		for _, w := range g.Adjacent(v) {
+			u[v] = append(u[v], w)
+			u[w] = append(u[w], v)
+		}
+	}
+	return undirectedList{u}
+}
+
+// indegrees returns the number of edges into each vertex of g.
+func indegrees(g datastructs.Adjacency) []int {
+	indegree := make([]int, g.NumVertices())
+	for v := 0; v < g.NumVertices(); v++ {
+		for _, w := range g.Adjacent(v) {
+			indegree[w] = indegree[w] + 1
+		}
+	}
+	return indegree
+}
+
+// numEdges returns the total length of the adjacency lists of g: the number of
+// edges in a digraph, or twice the number in an undirected graph.
+func numEdges(g datastructs.Adjacency) int {
+	n := 0
+	for v := 0; v < g.NumVertices(); v++ {
+		n = n + len(g.Adjacent(v))
+	}
+	return n
+}
@@ -0,0 +1,176 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// mapGraph is a graph that keeps its adjacency lists in a map, standing in for
+// a representation defined outside this module, such as a cache in front of a
+// database. Vertices with no entry have no neighbours.
+type mapGraph struct {
+	v   int
+	adj map[int][]int
+}
+
+func (g mapGraph) NumVertices() int {
+	return g.v
+}
+
+func (g mapGraph) Adjacent(v int) []int {
+	return g.adj[v]
+}
+
+// undirectedMapGraph is a mapGraph with symmetric adjacency lists.
+type undirectedMapGraph struct {
+	mapGraph
+}
+
+func (g undirectedMapGraph) Undirected() {}
+
+// toMapGraph copies the adjacency lists of g, in order, into a mapGraph.
+func toMapGraph(g datastructs.Adjacency) mapGraph {
+	m := mapGraph{v: g.NumVertices(), adj: map[int][]int{}}
+	for v := 0; v < g.NumVertices(); v++ {
+		if len(g.Adjacent(v)) > 0 {
+			m.adj[v] = append([]int(nil), g.Adjacent(v)...)
+		}
+	}
+	return m
+}
+
+// sortedAdj returns the adjacency lists of g with each list sorted.
+func sortedAdj(g datastructs.Adjacency) [][]int {
+	adj := make([][]int, g.NumVertices())
+	for v := range adj {
+		adj[v] = append([]int{}, g.Adjacent(v)...)
+		sort.Ints(adj[v])
+	}
+	return adj
+}
+
+func TestReverse(t *testing.T) {
+	g := tinyDG()
+	want := sortedAdj(g.Reverse())
+	got := sortedAdj(reverse(g))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v; got %v", want, got)
+	}
+}
+
+func TestUndirected(t *testing.T) {
+	// tinyDAG has no pair of opposite edges, which Graph would store only once
+	d := tinyDAG()
+	g := datastructs.CreateGraph(d.V)
+	for v := 0; v < d.V; v++ {
+		for _, w := range d.Adj[v] {
+			g.AddEdge(v, w)
+		}
+	}
+	want := sortedAdj(g)
+	got := sortedAdj(undirected(d))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v; got %v", want, got)
+	}
+}
+
+func TestMapGraph(t *testing.T) {
+	g := tinyDG()
+	m := toMapGraph(g)
+	testCases := []struct {
+		name string
+		run  func(g datastructs.Adjacency) any
+	}{
+		{"bfs", func(g datastructs.Adjacency) any { return visitOrder(BFS, g, 0) }},
+		{"dfs", func(g datastructs.Adjacency) any { return visitOrder(DFS, g, 0) }},
+		{"dfs-iterative", func(g datastructs.Adjacency) any { return visitOrder(DFSIterative, g, 0) }},
+		{"breadth-first-paths", func(g datastructs.Adjacency) any { return NewBreadthFirstPaths(g, 7).PathTo(1) }},
+		{"depth-first-paths", func(g datastructs.Adjacency) any { return NewDepthFirstPaths(g, 7).PathTo(1) }},
+		{"depth-first-order", func(g datastructs.Adjacency) any { return NewDepthFirstOrder(g).PostOrder() }},
+		{"directed-cycle", func(g datastructs.Adjacency) any { return NewDirectedCycle(g).Cycle() }},
+		{"kosaraju-sharir", func(g datastructs.Adjacency) any { return NewKosarajuSharirSCC(g).Components() }},
+		{"tarjan", func(g datastructs.Adjacency) any { return NewTarjanSCC(g).Components() }},
+		{"condensation", func(g datastructs.Adjacency) any { dag := NewTarjanSCC(g).Condensation(); return dag.String() }},
+		{"directed-bipartite", func(g datastructs.Adjacency) any { return NewDirectedBipartite(g).OddCycle() }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := tc.run(g)
+			got := tc.run(m)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v; got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestMapGraphUndirected(t *testing.T) {
+	g := tinyG()
+	m := undirectedMapGraph{toMapGraph(g)}
+	testCases := []struct {
+		name string
+		run  func(g datastructs.Undirected) any
+	}{
+		{"connected-components", func(g datastructs.Undirected) any { return NewConnectedComponents(g).Components() }},
+		{"cycle", func(g datastructs.Undirected) any { return NewCycle(g).Cycle() }},
+		{"bipartite", func(g datastructs.Undirected) any { return NewBipartite(g).OddCycle() }},
+		{"articulation-points", func(g datastructs.Undirected) any { return NewBiconnected(g).ArticulationPoints() }},
+		{"bridges", func(g datastructs.Undirected) any { return NewBiconnected(g).Bridges() }},
+		{"bidirectional-bfs", func(g datastructs.Undirected) any { return BidirectionalBFS(g, 1, 3) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want := tc.run(g)
+			got := tc.run(m)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v; got %v", want, got)
+			}
+		})
+	}
+}
+
+func Example_adjacency() {
+	// any type that can count its vertices and list their neighbours works
+	// with the traversal algorithms; here, courses and their prerequisites
+	// are kept in a map
+	courses := []string{"algebra", "calculus", "linear algebra", "probability", "machine learning"}
+	g := mapGraph{v: len(courses), adj: map[int][]int{
+		0: {1, 2},
+		1: {3, 4},
+		2: {4},
+		3: {4},
+	}}
+	top, err := NewKahnTopological(g, nil)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, v := range top.Order() {
+		fmt.Println(courses[v])
+	}
+	// Output:
+	// algebra
+	// calculus
+	// linear algebra
+	// probability
+	// machine learning
+}
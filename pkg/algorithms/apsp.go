@@ -0,0 +1,86 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"strings"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// AllPairsShortestPaths is the API shared by the all-pairs shortest-path
+// algorithms on edge-weighted digraphs.
+type AllPairsShortestPaths interface {
+	// Dist returns the length of a shortest path from s to t, or positive
+	// infinity if there is no such path.
+	Dist(s int, t int) float64
+	// HasPath returns true if there is a path from s to t.
+	HasPath(s int, t int) bool
+	// Path returns the edges on a shortest path from s to t, in order, or nil
+	// if there is no such path.
+	Path(s int, t int) []datastructs.DirectedEdge
+}
+
+// NegativeCycleError is the error returned when shortest paths are requested
+// for an edge-weighted digraph with a negative cycle, in which case they are
+// undefined.
+type NegativeCycleError struct {
+	// Cycle holds the edges of a negative cycle, in order.
+	Cycle []datastructs.DirectedEdge
+}
+
+func (e *NegativeCycleError) Error() string {
+	edges := make([]string, len(e.Cycle))
+	for i, edge := range e.Cycle {
+		edges[i] = edge.String()
+	}
+	return "digraph has a negative cycle: " + strings.Join(edges, ", ")
+}
+
+// apsp holds a shortest-paths tree from every vertex. It's shared by the
+// all-pairs shortest-path algorithms, which differ only in how they build the
+// trees.
+type apsp struct {
+	trees []spt // trees[s] = shortest-paths tree from s
+}
+
+func newAPSP(v int) apsp {
+	a := apsp{trees: make([]spt, v)}
+	for s := 0; s < v; s++ {
+		a.trees[s] = newSPT(v, s)
+	}
+	return a
+}
+
+// Dist returns the length of a shortest path from s to t, or positive infinity
+// if there is no such path.
+func (a *apsp) Dist(s int, t int) float64 {
+	validateVertex(s, len(a.trees))
+	return a.trees[s].DistTo(t)
+}
+
+// HasPath returns true if there is a path from s to t.
+func (a *apsp) HasPath(s int, t int) bool {
+	validateVertex(s, len(a.trees))
+	return a.trees[s].HasPathTo(t)
+}
+
+// Path returns the edges on a shortest path from s to t, in order, or nil if
+// there is no such path.
+func (a *apsp) Path(s int, t int) []datastructs.DirectedEdge {
+	validateVertex(s, len(a.trees))
+	return a.trees[s].PathTo(t)
+}
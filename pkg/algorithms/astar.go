@@ -0,0 +1,72 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// Heuristic estimates the length of a shortest path from v to the target.
+type Heuristic func(v int) float64
+
+// AStar finds a shortest path from s to t in the edge-weighted digraph g, using
+// the A* algorithm. It's Dijkstra's algorithm with each vertex v prioritized by
+// its distance from s plus heuristic(v), which steers the search toward t, and
+// it stops as soon as t is settled. It returns the edges on the path, in order,
+// and the path's length, or nil and positive infinity if there is no such path.
+//
+// The path is a shortest one as long as heuristic never overestimates the
+// distance to t; if it also never decreases by more than the weight of an edge
+// along that edge, no vertex is explored twice. A nil heuristic is taken to be
+// 0 everywhere, which gives Dijkstra's algorithm with early exit. AStar panics
+// if it relaxes an edge with a negative weight.
+func AStar(g datastructs.EdgeWeightedDigraph, s int, t int, heuristic Heuristic) ([]datastructs.DirectedEdge, float64) {
+	validateVertex(s, g.V)
+	validateVertex(t, g.V)
+	if heuristic == nil {
+		heuristic = func(int) float64 { return 0 }
+	}
+
+	tree := newSPT(g.V, s)
+	pq := datastructs.NewIndexMinPQ[float64](g.V)
+	pq.Insert(s, heuristic(s))
+	for !pq.IsEmpty() {
+		v := pq.DelMin()
+		if v == t {
+			return tree.PathTo(t), tree.distTo[t]
+		}
+		for i := range g.Adj[v] {
+			e := &g.Adj[v][i]
+			if e.Weight < 0 {
+				panic(fmt.Sprintf("edge %v has negative weight", e))
+			}
+			w := e.To
+			if tree.distTo[w] > tree.distTo[v]+e.Weight {
+				tree.distTo[w] = tree.distTo[v] + e.Weight
+				tree.edgeTo[w] = e
+				if pq.Contains(w) {
+					pq.ChangeKey(w, tree.distTo[w]+heuristic(w))
+				} else {
+					pq.Insert(w, tree.distTo[w]+heuristic(w))
+				}
+			}
+		}
+	}
+	return nil, math.Inf(1)
+}
@@ -0,0 +1,156 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// gridEWD returns an n-by-n grid digraph with edges both ways between
+// neighbouring cells, each of weight 1. Cell (x, y) is vertex y*n + x.
+func gridEWD(n int) datastructs.EdgeWeightedDigraph {
+	g := datastructs.CreateEdgeWeightedDigraph(n * n)
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			v := y*n + x
+			if x+1 < n {
+				g.AddEdge(datastructs.DirectedEdge{From: v, To: v + 1, Weight: 1})
+				g.AddEdge(datastructs.DirectedEdge{From: v + 1, To: v, Weight: 1})
+			}
+			if y+1 < n {
+				g.AddEdge(datastructs.DirectedEdge{From: v, To: v + n, Weight: 1})
+				g.AddEdge(datastructs.DirectedEdge{From: v + n, To: v, Weight: 1})
+			}
+		}
+	}
+	return g
+}
+
+// checkP2P verifies that path runs from s to t, is connected, and has total
+// weight dist, which must match the length of a shortest path found by sp.
+func checkP2P(t *testing.T, sp ShortestPaths, s int, v int, path []datastructs.DirectedEdge, dist float64) {
+	t.Helper()
+	if !sp.HasPathTo(v) {
+		if path != nil || !math.IsInf(dist, 1) {
+			t.Errorf("%v->%v: expected %v and %v; got %v and %v", s, v, nil, math.Inf(1), path, dist)
+		}
+		return
+	}
+	if math.Abs(dist-sp.DistTo(v)) > 1e-9 {
+		t.Errorf("%v->%v: expected %v; got %v", s, v, sp.DistTo(v), dist)
+	}
+	at, sum := s, 0.0
+	for _, e := range path {
+		if e.From != at {
+			t.Errorf("%v->%v: path %v is not connected", s, v, path)
+		}
+		at = e.To
+		sum = sum + e.Weight
+	}
+	if at != v {
+		t.Errorf("%v->%v: expected path to end at %v; got %v", s, v, v, at)
+	}
+	if math.Abs(sum-dist) > 1e-9 {
+		t.Errorf("%v->%v: expected path weight %v; got %v", s, v, dist, sum)
+	}
+}
+
+func TestAStar(t *testing.T) {
+	g := tinyEWD()
+	for s := 0; s < g.V; s++ {
+		sp := NewDijkstraSP(g, s)
+		for v := 0; v < g.V; v++ {
+			path, dist := AStar(g, s, v, nil)
+			checkP2P(t, sp, s, v, path, dist)
+		}
+	}
+}
+
+func TestAStarUnreachable(t *testing.T) {
+	g := edgeWeightedDigraph(3, []datastructs.DirectedEdge{{From: 0, To: 1, Weight: 1}})
+	path, dist := AStar(g, 0, 2, nil)
+	if path != nil || !math.IsInf(dist, 1) {
+		t.Errorf("expected %v and %v; got %v and %v", nil, math.Inf(1), path, dist)
+	}
+}
+
+func TestAStarRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		v := 1 + r.Intn(40)
+		g := randomEdgeWeightedDigraph(r, v, 3*v, 0, 1)
+		s, target := r.Intn(v), r.Intn(v)
+		// half the true distance to the target is a consistent heuristic
+		toTarget := NewDijkstraSP(g.Reverse(), target)
+		h := func(v int) float64 {
+			if !toTarget.HasPathTo(v) {
+				return 0
+			}
+			return toTarget.DistTo(v) / 2
+		}
+		path, dist := AStar(g, s, target, h)
+		checkP2P(t, NewDijkstraSP(g, s), s, target, path, dist)
+	}
+}
+
+func TestAStarStopsEarly(t *testing.T) {
+	n := 100
+	g := gridEWD(n)
+	s, target := 0, 5*n+5 // (0, 0) to (5, 5)
+	explored := map[int]bool{}
+	manhattan := func(v int) float64 {
+		explored[v] = true
+		return math.Abs(float64(v%n-5)) + math.Abs(float64(v/n-5))
+	}
+	path, dist := AStar(g, s, target, manhattan)
+	if dist != 10 || len(path) != 10 {
+		t.Errorf("expected %v and %v; got %v and %v", 10, 10, dist, len(path))
+	}
+	// only cells in or next to the 6-by-6 box between (0, 0) and (5, 5) are on a
+	// shortest path or adjacent to one
+	if len(explored) > 7*7 {
+		t.Errorf("expected at most %v vertices explored; got %v", 7*7, len(explored))
+	}
+}
+
+func TestAStarNegativeWeight(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected AStar to panic on a negative edge weight")
+		}
+	}()
+	g := edgeWeightedDigraph(2, []datastructs.DirectedEdge{{From: 0, To: 1, Weight: -1}})
+	AStar(g, 0, 1, nil)
+}
+
+func ExampleAStar() {
+	path, dist := AStar(tinyEWD(), 0, 6, nil)
+	fmt.Printf("%.2f\n", dist)
+	for _, e := range path {
+		fmt.Println(e)
+	}
+	// Output:
+	// 1.51
+	// 0->2 0.26000
+	// 2->7 0.34000
+	// 7->3 0.39000
+	// 3->6 0.52000
+}
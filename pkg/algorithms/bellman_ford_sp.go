@@ -0,0 +1,165 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// BellmanFordSP solves the single-source shortest-paths problem in edge-weighted
+// digraphs with no negative cycles. Edge weights can be negative. If a negative
+// cycle is reachable from the source, it finds one instead.
+type BellmanFordSP struct {
+	spt
+	onQueue []bool                     // onQueue[v] = is v currently on the queue?
+	queue   datastructs.Queue[int]     // queue of vertices to relax
+	cost    int                        // number of calls to relax
+	cycle   []datastructs.DirectedEdge // negative cycle, or nil if there is no such cycle
+}
+
+// NewBellmanFordSP computes a shortest-paths tree from source vertex s to every
+// other vertex in g, using the queue-based version of the Bellman-Ford
+// algorithm. It takes O(EV) time in the worst case, but is typically much
+// faster. It stops as soon as it finds a negative cycle reachable from s,
+// which is then available from NegativeCycle.
+func NewBellmanFordSP(g datastructs.EdgeWeightedDigraph, s int) *BellmanFordSP {
+	validateVertex(s, g.V)
+	sp := &BellmanFordSP{spt: newSPT(g.V, s), onQueue: make([]bool, g.V)}
+
+	sp.queue.Enqueue(s)
+	sp.onQueue[s] = true
+	for !sp.queue.IsEmpty() && !sp.HasNegativeCycle() {
+		v := sp.queue.Dequeue()
+		sp.onQueue[v] = false
+		sp.relax(g, v)
+	}
+	return sp
+}
+
+// relax relaxes all of the edges leaving v.
+func (sp *BellmanFordSP) relax(g datastructs.EdgeWeightedDigraph, v int) {
+	for i := range g.Adj[v] {
+		e := &g.Adj[v][i]
+		w := e.To
+		if sp.distTo[w] > sp.distTo[v]+e.Weight {
+			sp.distTo[w] = sp.distTo[v] + e.Weight
+			sp.edgeTo[w] = e
+			if !sp.onQueue[w] {
+				sp.queue.Enqueue(w)
+				sp.onQueue[w] = true
+			}
+		}
+		// every V relaxations, check the shortest-paths tree for a cycle;
+		// any cycle in it must have negative weight
+		sp.cost = sp.cost + 1
+		if sp.cost%g.V == 0 {
+			sp.cycle = negativeCycle(sp.edgeTo)
+			if sp.HasNegativeCycle() {
+				return
+			}
+		}
+	}
+}
+
+// negativeCycle looks for a cycle in the parent links edgeTo of a
+// shortest-paths tree and returns its edges in order, or nil if there is none.
+// Since each vertex has at most one parent, it follows the links from each
+// vertex until it reaches a root, a vertex finished earlier, or a vertex on the
+// current walk, which closes a cycle. Any such cycle has negative weight.
+func negativeCycle(edgeTo []*datastructs.DirectedEdge) []datastructs.DirectedEdge {
+	const (
+		unvisited = iota
+		onWalk
+		done
+	)
+	parent := func(v int) int {
+		if edgeTo[v] == nil {
+			return -1
+		}
+		return edgeTo[v].From
+	}
+	n := len(edgeTo)
+	state := make([]int, n)
+	for s := 0; s < n; s++ {
+		v := s
+		for v != -1 && state[v] == unvisited {
+			state[v] = onWalk
+			v = parent(v)
+		}
+		if v != -1 && state[v] == onWalk {
+			// the walk returned to v, so follow the links once more to collect
+			// the cycle's edges; they come out in reverse order
+			path := datastructs.Stack[datastructs.DirectedEdge]{}
+			for x := v; ; {
+				e := edgeTo[x]
+				path.Push(*e)
+				x = e.From
+				if x == v {
+					break
+				}
+			}
+			cycle := make([]datastructs.DirectedEdge, 0, path.Size())
+			for !path.IsEmpty() {
+				cycle = append(cycle, path.Pop())
+			}
+			return cycle
+		}
+		for v = s; v != -1 && state[v] == onWalk; v = parent(v) {
+			state[v] = done
+		}
+	}
+	return nil
+}
+
+// HasNegativeCycle returns true if there is a negative cycle reachable from the source.
+func (sp *BellmanFordSP) HasNegativeCycle() bool {
+	return sp.cycle != nil
+}
+
+// NegativeCycle returns the edges of a negative cycle reachable from the
+// source, in order, or nil if there is no such cycle.
+func (sp *BellmanFordSP) NegativeCycle() []datastructs.DirectedEdge {
+	return sp.cycle
+}
+
+// DistTo returns the length of a shortest path from the source to v, or
+// positive infinity if there is no such path. It panics if there is a negative
+// cycle reachable from the source, since shortest paths are then undefined.
+func (sp *BellmanFordSP) DistTo(v int) float64 {
+	sp.checkNegativeCycle()
+	return sp.spt.DistTo(v)
+}
+
+// HasPathTo returns true if there is a path from the source to v. It panics if
+// there is a negative cycle reachable from the source.
+func (sp *BellmanFordSP) HasPathTo(v int) bool {
+	sp.checkNegativeCycle()
+	return sp.spt.HasPathTo(v)
+}
+
+// PathTo returns the edges on a shortest path from the source to v, in order,
+// or nil if there is no such path. It panics if there is a negative cycle
+// reachable from the source.
+func (sp *BellmanFordSP) PathTo(v int) []datastructs.DirectedEdge {
+	sp.checkNegativeCycle()
+	return sp.spt.PathTo(v)
+}
+
+func (sp *BellmanFordSP) checkNegativeCycle() {
+	if sp.HasNegativeCycle() {
+		panic("negative cost cycle exists")
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyEWDn returns the edge-weighted digraph from tinyEWDn.txt in algs4, which
+// has negative weights but no negative cycles.
+func tinyEWDn() datastructs.EdgeWeightedDigraph {
+	return edgeWeightedDigraph(8, []datastructs.DirectedEdge{
+		{From: 4, To: 5, Weight: 0.35},
+		{From: 5, To: 4, Weight: 0.35},
+		{From: 4, To: 7, Weight: 0.37},
+		{From: 5, To: 7, Weight: 0.28},
+		{From: 7, To: 5, Weight: 0.28},
+		{From: 5, To: 1, Weight: 0.32},
+		{From: 0, To: 4, Weight: 0.38},
+		{From: 0, To: 2, Weight: 0.26},
+		{From: 7, To: 3, Weight: 0.39},
+		{From: 1, To: 3, Weight: 0.29},
+		{From: 2, To: 7, Weight: 0.34},
+		{From: 6, To: 2, Weight: -1.20},
+		{From: 3, To: 6, Weight: 0.52},
+		{From: 6, To: 0, Weight: -1.40},
+		{From: 6, To: 4, Weight: -1.25},
+	})
+}
+
+func TestBellmanFordSP(t *testing.T) {
+	sp := NewBellmanFordSP(tinyEWDn(), 0)
+	if sp.HasNegativeCycle() {
+		t.Fatalf("unexpected negative cycle %v", sp.NegativeCycle())
+	}
+	testCases := []struct {
+		name string
+		v    int
+		dist float64
+	}{
+		{"0", 0, 0.00},
+		{"1", 1, 0.93},
+		{"2", 2, 0.26},
+		{"3", 3, 0.99},
+		{"4", 4, 0.26},
+		{"5", 5, 0.61},
+		{"6", 6, 1.51},
+		{"7", 7, 0.60},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if math.Abs(sp.DistTo(tc.v)-tc.dist) > 1e-9 {
+				t.Errorf("expected %v; got %v", tc.dist, sp.DistTo(tc.v))
+			}
+			checkPath(t, sp, 0, tc.v)
+		})
+	}
+}
+
+func TestBellmanFordSPNegativeCycle(t *testing.T) {
+	// tinyEWDnc.txt in algs4: 4->5->4 is a cycle of weight -0.31
+	g := tinyEWD()
+	g.AddEdge(datastructs.DirectedEdge{From: 5, To: 4, Weight: -0.66})
+	sp := NewBellmanFordSP(g, 0)
+	if !sp.HasNegativeCycle() {
+		t.Fatalf("expected %v; got %v", true, sp.HasNegativeCycle())
+	}
+	cycle := sp.NegativeCycle()
+	sum := 0.0
+	for i, e := range cycle {
+		next := cycle[(i+1)%len(cycle)]
+		if e.To != next.From {
+			t.Errorf("cycle %v is not connected", cycle)
+		}
+		sum = sum + e.Weight
+	}
+	if sum >= 0 {
+		t.Errorf("expected a negative cycle; got weight %v", sum)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected DistTo to panic when there is a negative cycle")
+		}
+	}()
+	sp.DistTo(1)
+}
+
+func TestBellmanFordSPUnreachableNegativeCycle(t *testing.T) {
+	// the negative cycle 1->2->1 can't be reached from 0
+	g := edgeWeightedDigraph(3, []datastructs.DirectedEdge{
+		{From: 1, To: 2, Weight: -1},
+		{From: 2, To: 1, Weight: -1},
+		{From: 1, To: 0, Weight: 1},
+	})
+	sp := NewBellmanFordSP(g, 0)
+	if sp.HasNegativeCycle() {
+		t.Errorf("expected %v; got %v", false, sp.HasNegativeCycle())
+	}
+	if sp.HasPathTo(1) {
+		t.Errorf("expected %v; got %v", false, sp.HasPathTo(1))
+	}
+}
+
+func TestBellmanFordSPRandomNegativeCycles(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(20)
+		g := randomEdgeWeightedDigraph(r, v, 2*v, -0.5, 1)
+		sp := NewBellmanFordSP(g, r.Intn(v))
+		if !sp.HasNegativeCycle() {
+			continue
+		}
+		sum := 0.0
+		for _, e := range sp.NegativeCycle() {
+			sum = sum + e.Weight
+		}
+		if sum >= 0 {
+			t.Errorf("expected a negative cycle; got weight %v", sum)
+		}
+	}
+}
+
+func ExampleBellmanFordSP() {
+	g := tinyEWD()
+	g.AddEdge(datastructs.DirectedEdge{From: 5, To: 4, Weight: -0.66})
+	sp := NewBellmanFordSP(g, 0)
+	for _, e := range sp.NegativeCycle() {
+		fmt.Println(e)
+	}
+	// Output:
+	// 5->4 -0.66000
+	// 4->5 0.35000
+}
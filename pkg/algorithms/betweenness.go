@@ -0,0 +1,98 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// BetweennessCentrality computes the betweenness centrality of every vertex in
+// graph g using Brandes' algorithm. The betweenness of v is the sum, over all
+// ordered pairs of distinct vertices s and t other than v, of the fraction of
+// shortest s-t paths that pass through v. It runs a breadth-first search from
+// every vertex, counting shortest paths on the way out and accumulating each
+// vertex's share of them on the way back, so it takes O(EV) time.
+//
+// g can be a Digraph, or an undirected Graph, in which case every pair is
+// counted in both orders; halve the scores to count each pair once.
+func BetweennessCentrality(g datastructs.Adjacency) []float64 {
+	n := g.NumVertices()
+	score := make([]float64, n)
+	paths := newPathCounts(n)
+	delta := make([]float64, n) // delta[v] = dependency of s on v
+	for s := 0; s < n; s++ {
+		paths.bfs(g, s)
+		dist, sigma := paths.dist, paths.sigma
+		for v := 0; v < n; v++ {
+			delta[v] = 0
+		}
+
+		// visit the vertices farthest first, passing each one's dependency back
+		// to the vertices before it on shortest paths
+		for i := len(paths.order) - 1; i >= 0; i-- {
+			w := paths.order[i]
+			for _, x := range g.Adjacent(w) {
+				if dist[x] == dist[w]+1 {
+					delta[w] = delta[w] + sigma[w]/sigma[x]*(1+delta[x])
+				}
+			}
+			if w != s {
+				score[w] = score[w] + delta[w]
+			}
+		}
+	}
+	return score
+}
+
+// pathCounts holds the result of a breadth-first search that counts shortest
+// paths from a source vertex, for BetweennessCentrality and ClosenessCentrality.
+// Its slices are reused by each call to bfs, so one pathCounts can serve every
+// source.
+type pathCounts struct {
+	dist  []int     // dist[v] = number of edges on a shortest s-v path, or -1 if there is none
+	sigma []float64 // sigma[v] = number of shortest s-v paths
+	order []int     // vertices reachable from s, in the order they were discovered
+}
+
+func newPathCounts(n int) *pathCounts {
+	return &pathCounts{dist: make([]int, n), sigma: make([]float64, n), order: make([]int, 0, n)}
+}
+
+// bfs runs a breadth-first search on graph g from vertex s. Every shortest s-w
+// path ends with an edge v->w from a vertex v one step closer to s, so sigma[w]
+// is the sum of sigma[v] over those predecessors.
+func (c *pathCounts) bfs(g datastructs.Adjacency, s int) {
+	for v := range c.dist {
+		c.dist[v] = -1
+		c.sigma[v] = 0
+	}
+	c.dist[s] = 0
+	c.sigma[s] = 1
+	c.order = append(c.order[:0], s)
+	// order doubles as the queue: the vertices after i are yet to be explored
+	for i := 0; i < len(c.order); i++ {
+		v := c.order[i]
+		for _, w := range g.Adjacent(v) {
+			if c.dist[w] == -1 {
+				c.dist[w] = c.dist[v] + 1
+				c.order = append(c.order, w)
+			}
+			if c.dist[w] == c.dist[v]+1 {
+				c.sigma[w] = c.sigma[w] + c.sigma[v]
+			}
+		}
+	}
+}
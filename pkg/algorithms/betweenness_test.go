@@ -0,0 +1,175 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// bruteBetweenness computes betweenness centrality straight from the
+// definition, counting the shortest paths between every pair of vertices.
+func bruteBetweenness(g datastructs.Adjacency) []float64 {
+	n := g.NumVertices()
+	dist := make([][]int, n)
+	sigma := make([][]float64, n) // sigma[s][t] = number of shortest s-t paths
+	for s := 0; s < n; s++ {
+		paths := NewBreadthFirstPaths(g, s)
+		dist[s] = make([]int, n)
+		sigma[s] = make([]float64, n)
+		maxDist := 0
+		for t := 0; t < n; t++ {
+			dist[s][t] = paths.DistTo(t)
+			if dist[s][t] > maxDist {
+				maxDist = dist[s][t]
+			}
+		}
+		sigma[s][s] = 1
+		for d := 1; d <= maxDist; d++ {
+			for u := 0; u < n; u++ {
+				if dist[s][u] != d-1 {
+					continue
+				}
+				for _, t := range g.Adjacent(u) {
+					if dist[s][t] == d {
+						sigma[s][t] = sigma[s][t] + sigma[s][u]
+					}
+				}
+			}
+		}
+	}
+	score := make([]float64, n)
+	for v := 0; v < n; v++ {
+		for s := 0; s < n; s++ {
+			for t := 0; t < n; t++ {
+				if s == v || t == v || s == t || dist[s][t] == -1 || dist[s][v] == -1 || dist[v][t] == -1 {
+					continue
+				}
+				if dist[s][v]+dist[v][t] == dist[s][t] {
+					score[v] = score[v] + sigma[s][v]*sigma[v][t]/sigma[s][t]
+				}
+			}
+		}
+	}
+	return score
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	testCases := []struct {
+		name string
+		g    datastructs.Graph
+		want []float64
+	}{
+		{"path", gen.Path(5), []float64{0, 6, 8, 6, 0}},
+		{"star", gen.Star(6), []float64{20, 0, 0, 0, 0, 0}},
+		{"cycle", gen.Cycle(4), []float64{1, 1, 1, 1}},
+		{"complete", gen.Complete(4), []float64{0, 0, 0, 0}},
+		{"empty", datastructs.CreateGraph(0), []float64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := BetweennessCentrality(tc.g)
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v; got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBetweennessCentralityRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := datastructs.NewGraphGenerator(r)
+	var graphs []datastructs.Adjacency
+	for i := 0; i < 20; i++ {
+		v := 1 + r.Intn(25)
+		graphs = append(graphs, gen.ErdosRenyi(v, 0.15))
+		d := datastructs.CreateDigraph(v)
+		for j := 0; j < 2*v; j++ {
+			d.AddEdge(r.Intn(v), r.Intn(v))
+		}
+		graphs = append(graphs, d)
+	}
+	for _, g := range graphs {
+		want := bruteBetweenness(g)
+		got := BetweennessCentrality(g)
+		for v := range want {
+			if math.Abs(got[v]-want[v]) > 1e-9 {
+				t.Errorf("vertex %v: expected %v; got %v", v, want[v], got[v])
+			}
+		}
+	}
+}
+
+func TestPathCounts(t *testing.T) {
+	// a diamond with two shortest paths from 0 to 3, and an isolated vertex
+	g := datastructs.CreateGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	c := newPathCounts(g.V)
+	testCases := []struct {
+		name  string
+		s     int
+		dist  []int
+		sigma []float64
+		order []int
+	}{
+		{"0", 0, []int{0, 1, 1, 2, -1}, []float64{1, 1, 1, 2, 0}, []int{0, 1, 2, 3}},
+		{"3", 3, []int{2, 1, 1, 0, -1}, []float64{2, 1, 1, 1, 0}, []int{3, 1, 2, 0}},
+		// the search from 4 must not see anything left over from the others
+		{"4", 4, []int{-1, -1, -1, -1, 0}, []float64{0, 0, 0, 0, 1}, []int{4}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c.bfs(g, tc.s)
+			if !reflect.DeepEqual(c.dist, tc.dist) {
+				t.Errorf("expected %v; got %v", tc.dist, c.dist)
+			}
+			if !reflect.DeepEqual(c.sigma, tc.sigma) {
+				t.Errorf("expected %v; got %v", tc.sigma, c.sigma)
+			}
+			if !reflect.DeepEqual(c.order, tc.order) {
+				t.Errorf("expected %v; got %v", tc.order, c.order)
+			}
+		})
+	}
+}
+
+func ExampleBetweennessCentrality() {
+	// two triangles joined by the edge 2-3
+	g := datastructs.CreateGraph(6)
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {1, 2}, {2, 3}, {3, 4}, {3, 5}, {4, 5}} {
+		g.AddEdge(e[0], e[1])
+	}
+	for v, score := range BetweennessCentrality(g) {
+		// halve the scores, since each pair is counted in both orders
+		fmt.Println(v, score/2)
+	}
+	// Output:
+	// 0 0
+	// 1 0
+	// 2 6
+	// 3 6
+	// 4 0
+	// 5 0
+}
@@ -19,30 +19,34 @@ import (
 	"github.com/pcoet/golang-patterns/pkg/datastructs"
 )
 
-func bfs(g datastructs.Graph, s int, marked []bool, cb Proc) {
-	q := []int{}
+func bfs(g datastructs.Adjacency, s int, marked []bool, cb Proc) {
 	cb(s) // invoke the callback on the source vertex
 	marked[s] = true
-	q = append(q, s)
 
-	for len(q) != 0 {
-		var v int
-		v, q = q[0], q[1:] // dequeue
-
-		for _, w := range g.Adj[v] {
-			if !marked[w] {
-				cb(w) // invoke the callback on the current vertex
-				marked[w] = true
-				q = append(q, w)
+	// search one level at a time, reusing the backing arrays of the current
+	// and next levels instead of slicing off the front of a single queue,
+	// which would keep every vertex ever enqueued reachable
+	frontier, next := []int{s}, []int{}
+	for len(frontier) != 0 {
+		for _, v := range frontier {
+			for _, w := range g.Adjacent(v) {
+				if !marked[w] {
+					cb(w) // invoke the callback on the current vertex
+					marked[w] = true
+					next = append(next, w)
+				}
 			}
 		}
+		frontier, next = next, frontier[:0]
 	}
 }
 
 // BFS performs a breadth-first search on graph g, starting at vertex s. It invokes
-// a callback function on each discovered vertex.
-func BFS(g datastructs.Graph, s int, cb Proc) {
-	marked := make([]bool, g.V)
-	validateVertex(s, g.V)
+// a callback function on each discovered vertex. g can be any graph
+// representation, such as a Graph, a CSRGraph, or a Digraph, in which case the
+// search follows edges in their direction.
+func BFS(g datastructs.Adjacency, s int, cb Proc) {
+	marked := make([]bool, g.NumVertices())
+	validateVertex(s, g.NumVertices())
 	bfs(g, s, marked, cb)
 }
@@ -17,6 +17,8 @@ package algorithms
 
 import (
 	"fmt"
+	"math/rand"
+	"reflect"
 	"testing"
 
 	"github.com/pcoet/golang-patterns/pkg/datastructs"
@@ -48,6 +50,97 @@ func TestBFS(t *testing.T) {
 	}
 }
 
+func TestBFSDigraph(t *testing.T) {
+	g := datastructs.CreateDigraph(6)
+	g.AddEdge(0, 5)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 5)
+	g.AddEdge(3, 2)
+	g.AddEdge(4, 3)
+	g.AddEdge(4, 2)
+	g.AddEdge(5, 4)
+
+	testCases := []struct {
+		name string
+		s    int
+		want []int
+	}{
+		{"t1", 0, []int{0, 5, 1, 4, 3, 2}},
+		{"t2", 1, []int{1}},
+		{"t3", 4, []int{4, 3, 2, 5, 0, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []int
+			BFS(g, tc.s, func(v int) {
+				got = append(got, v)
+			})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v; got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestBFSGenerated(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	testCases := []struct {
+		name string
+		g    datastructs.Graph
+	}{
+		{"grid", gen.Grid(20, 30)},
+		{"tree", gen.Tree(500)},
+		{"sparse", gen.ErdosRenyi(500, 0.003)},
+		{"scale-free", gen.PreferentialAttachment(500, 2)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cc := NewConnectedComponents(tc.g)
+			paths := NewBreadthFirstPaths(tc.g, 0)
+			seen := make([]bool, tc.g.V)
+			last := 0
+			BFS(tc.g, 0, func(v int) {
+				if seen[v] {
+					t.Errorf("vertex %v visited twice", v)
+				}
+				seen[v] = true
+				// vertices are discovered in order of distance from the source
+				if paths.DistTo(v) < last {
+					t.Errorf("vertex %v at distance %v visited after distance %v", v, paths.DistTo(v), last)
+				}
+				last = paths.DistTo(v)
+			})
+			for v := 0; v < tc.g.V; v++ {
+				if seen[v] != cc.Connected(0, v) {
+					t.Errorf("expected %v; got %v", cc.Connected(0, v), seen[v])
+				}
+			}
+		})
+	}
+}
+
+// visitOrder returns the vertices in the order that traverse visits them from s.
+func visitOrder(traverse func(datastructs.Adjacency, int, Proc), g datastructs.Adjacency, s int) []int {
+	var order []int
+	traverse(g, s, func(v int) {
+		order = append(order, v)
+	})
+	return order
+}
+
+func TestBFSCSRGraph(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	for _, g := range []datastructs.Graph{gen.Grid(20, 30), gen.Simple(500, 800), gen.PreferentialAttachment(500, 3)} {
+		want := visitOrder(BFS, g, 0)
+		got := visitOrder(BFS, datastructs.CreateCSRGraph(g), 0)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v; got %v", want, got)
+		}
+	}
+}
+
 func ExampleBFS() {
 	g := datastructs.CreateGraph(13)
 	g.AddEdge(0, 1)
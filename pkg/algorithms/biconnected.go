@@ -0,0 +1,176 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"sort"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// Biconnected finds the articulation points, bridges, and biconnected
+// components of an undirected graph. An articulation point (or cut vertex) is a
+// vertex whose removal disconnects part of the graph, and a bridge is an edge
+// whose removal does the same.
+type Biconnected struct {
+	pre          []int  // pre[v] = order in which dfs examines v, or -1 if unvisited
+	low          []int  // low[v] = lowest preorder of any vertex connected to v
+	cnt          int    // preorder counter
+	articulation []bool // articulation[v] = is v an articulation point?
+	bridges      [][2]int
+	edges        datastructs.Stack[[2]int] // tree and back edges of the current block
+	vertices     datastructs.Stack[int]    // vertices of the current 2-edge-connected component
+	blocks       [][]int                   // vertex-biconnected components
+	twoEdge      [][]int                   // edge-biconnected components
+}
+
+// NewBiconnected analyzes the undirected graph g using Tarjan's low-link
+// algorithm: a single depth-first search that records, for each vertex v, the
+// lowest preorder number reachable from the subtree rooted at v using at most
+// one back edge. It takes O(E + V) time.
+func NewBiconnected(g datastructs.Undirected) *Biconnected {
+	b := &Biconnected{pre: make([]int, g.NumVertices()), low: make([]int, g.NumVertices()), articulation: make([]bool, g.NumVertices())}
+	for v := 0; v < g.NumVertices(); v++ {
+		b.pre[v] = -1
+	}
+	for v := 0; v < g.NumVertices(); v++ {
+		if b.pre[v] != -1 {
+			continue
+		}
+		b.dfs(g, -1, v)
+		// whatever is left belongs to the root's 2-edge-connected component
+		b.popEdgeComponent(v)
+	}
+	return b
+}
+
+// dfs searches from v, which was reached from u.
+func (b *Biconnected) dfs(g datastructs.Undirected, u int, v int) {
+	children := 0
+	b.pre[v] = b.cnt
+	b.cnt = b.cnt + 1
+	b.low[v] = b.pre[v]
+	b.vertices.Push(v)
+	for _, w := range g.Adjacent(v) {
+		if b.pre[w] == -1 {
+			children = children + 1
+			b.edges.Push([2]int{v, w})
+			b.dfs(g, v, w)
+			if b.low[w] < b.low[v] {
+				b.low[v] = b.low[w]
+			}
+			// no vertex in w's subtree reaches above v, so v separates it
+			if b.low[w] >= b.pre[v] {
+				if u != -1 {
+					b.articulation[v] = true
+				}
+				b.popBlock(v, w)
+			}
+			// no vertex in w's subtree reaches v or above, so v-w is the only link
+			if b.low[w] == b.pre[w] {
+				b.bridges = append(b.bridges, [2]int{v, w})
+				b.popEdgeComponent(w)
+			}
+		} else if w != u && b.pre[w] < b.pre[v] {
+			// back edge to an ancestor
+			b.edges.Push([2]int{v, w})
+			if b.pre[w] < b.low[v] {
+				b.low[v] = b.pre[w]
+			}
+		}
+	}
+	// the root is an articulation point if it has more than one child
+	if u == -1 && children > 1 {
+		b.articulation[v] = true
+	}
+}
+
+// popBlock pops the edges of the block that ends with tree edge v-w and
+// records its vertices.
+func (b *Biconnected) popBlock(v int, w int) {
+	seen := map[int]bool{}
+	var block []int
+	for {
+		e := b.edges.Pop()
+		for _, x := range e {
+			if !seen[x] {
+				seen[x] = true
+				block = append(block, x)
+			}
+		}
+		if e == [2]int{v, w} {
+			break
+		}
+	}
+	sort.Ints(block)
+	b.blocks = append(b.blocks, block)
+}
+
+// popEdgeComponent pops the vertices of the 2-edge-connected component rooted
+// at v and records them.
+func (b *Biconnected) popEdgeComponent(v int) {
+	var component []int
+	for {
+		x := b.vertices.Pop()
+		component = append(component, x)
+		if x == v {
+			break
+		}
+	}
+	sort.Ints(component)
+	b.twoEdge = append(b.twoEdge, component)
+}
+
+// IsArticulation returns true if vertex v is an articulation point.
+func (b *Biconnected) IsArticulation(v int) bool {
+	validateVertex(v, len(b.articulation))
+	return b.articulation[v]
+}
+
+// ArticulationPoints returns the articulation points, in ascending order.
+func (b *Biconnected) ArticulationPoints() []int {
+	var points []int
+	for v, isArticulation := range b.articulation {
+		if isArticulation {
+			points = append(points, v)
+		}
+	}
+	return points
+}
+
+// Bridges returns the bridges. Each is given as a pair of vertices, in the
+// order the depth-first search followed it.
+func (b *Biconnected) Bridges() [][2]int {
+	return b.bridges
+}
+
+// VertexBiconnectedComponents returns the vertices of each biconnected
+// component (or block): a maximal subgraph that stays connected after removing
+// any one vertex. Blocks share articulation points, and every edge is in
+// exactly one block. Isolated vertices aren't in any block. The vertices of
+// each block are in ascending order.
+func (b *Biconnected) VertexBiconnectedComponents() [][]int {
+	return b.blocks
+}
+
+// EdgeBiconnectedComponents returns the vertices of each 2-edge-connected
+// component: a maximal subgraph that stays connected after removing any one
+// edge. They're the connected components left after removing the bridges, so
+// every vertex is in exactly one. The vertices of each component are in
+// ascending order.
+func (b *Biconnected) EdgeBiconnectedComponents() [][]int {
+	return b.twoEdge
+}
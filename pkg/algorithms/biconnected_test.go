@@ -0,0 +1,159 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// without returns a copy of g without the edges incident to vertex x, or
+// without edge x-y if y isn't -1.
+func without(g datastructs.Graph, x int, y int) datastructs.Graph {
+	h := datastructs.CreateGraph(g.V)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if v > w || (y == -1 && (v == x || w == x)) || (v == x && w == y) || (v == y && w == x) {
+				continue
+			}
+			h.AddEdge(v, w)
+		}
+	}
+	return h
+}
+
+func TestBiconnected(t *testing.T) {
+	// two triangles joined by the bridge 2-3, with a pendant vertex 6 on 5
+	g := datastructs.CreateGraph(8)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 3)
+	g.AddEdge(5, 6)
+	b := NewBiconnected(g)
+
+	wantPoints := []int{2, 3, 5}
+	if !reflect.DeepEqual(b.ArticulationPoints(), wantPoints) {
+		t.Errorf("expected %v; got %v", wantPoints, b.ArticulationPoints())
+	}
+	wantBridges := [][2]int{{5, 6}, {2, 3}}
+	if !reflect.DeepEqual(b.Bridges(), wantBridges) {
+		t.Errorf("expected %v; got %v", wantBridges, b.Bridges())
+	}
+	wantBlocks := [][]int{{5, 6}, {3, 4, 5}, {2, 3}, {0, 1, 2}}
+	if !reflect.DeepEqual(b.VertexBiconnectedComponents(), wantBlocks) {
+		t.Errorf("expected %v; got %v", wantBlocks, b.VertexBiconnectedComponents())
+	}
+	wantTwoEdge := [][]int{{6}, {3, 4, 5}, {0, 1, 2}, {7}}
+	if !reflect.DeepEqual(b.EdgeBiconnectedComponents(), wantTwoEdge) {
+		t.Errorf("expected %v; got %v", wantTwoEdge, b.EdgeBiconnectedComponents())
+	}
+}
+
+func TestBiconnectedRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(20)
+		g := datastructs.CreateGraph(v)
+		for j := 0; j < v+r.Intn(v); j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a != b {
+				g.AddEdge(a, b)
+			}
+		}
+		b := NewBiconnected(g)
+		count := NewConnectedComponents(g).Count()
+
+		// removing an articulation point leaves more components than before,
+		// not counting the removed vertex itself
+		for x := 0; x < v; x++ {
+			isolated := 0
+			if g.Degree(x) > 0 {
+				isolated = 1
+			}
+			want := NewConnectedComponents(without(g, x, -1)).Count()-isolated > count
+			if b.IsArticulation(x) != want {
+				t.Errorf("expected IsArticulation(%v) to be %v; got %v", x, want, b.IsArticulation(x))
+			}
+		}
+
+		// removing a bridge leaves more components than before
+		bridges := map[[2]int]bool{}
+		for _, e := range b.Bridges() {
+			bridges[e] = true
+			bridges[[2]int{e[1], e[0]}] = true
+		}
+		for x := 0; x < v; x++ {
+			for _, y := range g.Adj[x] {
+				want := NewConnectedComponents(without(g, x, y)).Count() > count
+				if bridges[[2]int{x, y}] != want {
+					t.Errorf("expected %v-%v to be a bridge: %v; got %v", x, y, want, bridges[[2]int{x, y}])
+				}
+			}
+		}
+
+		// every vertex is in exactly one 2-edge-connected component, and every
+		// edge with both endpoints in one block
+		total := 0
+		for _, c := range b.EdgeBiconnectedComponents() {
+			total = total + len(c)
+		}
+		if total != v {
+			t.Errorf("expected %v; got %v", v, total)
+		}
+		edges := 0
+		for _, block := range b.VertexBiconnectedComponents() {
+			in := map[int]bool{}
+			for _, x := range block {
+				in[x] = true
+			}
+			for _, x := range block {
+				for _, y := range g.Adj[x] {
+					if x < y && in[y] {
+						edges = edges + 1
+					}
+				}
+			}
+		}
+		if edges != g.E {
+			t.Errorf("expected %v; got %v", g.E, edges)
+		}
+	}
+}
+
+func ExampleBiconnected() {
+	// a path 0-1-2 attached to a square 2-3-4-5
+	g := datastructs.CreateGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 5)
+	g.AddEdge(5, 2)
+	b := NewBiconnected(g)
+	fmt.Println(b.ArticulationPoints())
+	fmt.Println(b.Bridges())
+	// Output:
+	// [1 2]
+	// [[1 2] [0 1]]
+}
@@ -0,0 +1,196 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// BidirectionalBFS finds a path from s to t in the undirected graph g with the
+// fewest edges by running breadth-first searches from both ends at once. It
+// expands a level of whichever search has the smaller frontier, and stops
+// after the level in which the searches meet. It returns the vertices on the
+// path, from s to t, or nil if there is no such path.
+func BidirectionalBFS(g datastructs.Undirected, s int, t int) []int {
+	validateVertex(s, g.NumVertices())
+	validateVertex(t, g.NumVertices())
+	if s == t {
+		return []int{s}
+	}
+
+	type side struct {
+		dist     []int // dist[v] = number of edges on the path to v; -1 if v isn't reached
+		edgeTo   []int // edgeTo[v] = previous vertex on the path to v
+		frontier []int // vertices reached in the last level
+	}
+	newSide := func(s int) *side {
+		sd := &side{dist: make([]int, g.NumVertices()), edgeTo: make([]int, g.NumVertices()), frontier: []int{s}}
+		for v := range sd.dist {
+			sd.dist[v] = -1
+		}
+		sd.dist[s] = 0
+		return sd
+	}
+	fwd, bwd := newSide(s), newSide(t)
+
+	for len(fwd.frontier) > 0 && len(bwd.frontier) > 0 {
+		this, other := fwd, bwd
+		if len(bwd.frontier) < len(fwd.frontier) {
+			this, other = bwd, fwd
+		}
+		// finish the level even after the searches meet, since a later edge in
+		// it may give a shorter path
+		best, meetV, meetW := math.MaxInt, -1, -1
+		var next []int
+		for _, v := range this.frontier {
+			for _, w := range g.Adjacent(v) {
+				if this.dist[w] == -1 {
+					this.dist[w] = this.dist[v] + 1
+					this.edgeTo[w] = v
+					next = append(next, w)
+				}
+				if other.dist[w] != -1 && this.dist[v]+1+other.dist[w] < best {
+					best, meetV, meetW = this.dist[v]+1+other.dist[w], v, w
+				}
+			}
+		}
+		this.frontier = next
+		if meetV == -1 {
+			continue
+		}
+		// the path is s ~> a - b ~> t, where a was reached from s and b from t
+		a, b := meetV, meetW
+		if this == bwd {
+			a, b = meetW, meetV
+		}
+		path := datastructs.Stack[int]{}
+		for x := a; x != s; x = fwd.edgeTo[x] {
+			path.Push(x)
+		}
+		path.Push(s)
+		vertices := popAll(&path)
+		for x := b; x != t; x = bwd.edgeTo[x] {
+			vertices = append(vertices, x)
+		}
+		return append(vertices, t)
+	}
+	return nil
+}
+
+// BidirectionalDijkstraSP answers shortest-path queries between pairs of
+// vertices in an edge-weighted digraph with bidirectional Dijkstra searches.
+// It holds copies of the digraph and of its reverse, so the reverse is built
+// once for all of the queries, and later changes to the digraph don't affect
+// it.
+type BidirectionalDijkstraSP struct {
+	g datastructs.EdgeWeightedDigraph // digraph to search forward from the source
+	r datastructs.EdgeWeightedDigraph // reverse of g, to search backward from the target
+}
+
+// NewBidirectionalDijkstraSP prepares the edge-weighted digraph g for
+// shortest-path queries by copying it and computing its reverse, which takes
+// O(E + V) time.
+func NewBidirectionalDijkstraSP(g datastructs.EdgeWeightedDigraph) *BidirectionalDijkstraSP {
+	c := datastructs.CreateEdgeWeightedDigraph(g.V)
+	for v := 0; v < g.V; v++ {
+		for _, e := range g.Adj[v] {
+			c.AddEdge(e)
+		}
+	}
+	return &BidirectionalDijkstraSP{g: c, r: c.Reverse()}
+}
+
+// BidirectionalDijkstra finds a shortest path from s to t in the edge-weighted
+// digraph g. It builds the reverse of g for the search, so callers answering
+// many queries on the same digraph should use a BidirectionalDijkstraSP
+// instead. See BidirectionalDijkstraSP.Path.
+func BidirectionalDijkstra(g datastructs.EdgeWeightedDigraph, s int, t int) ([]datastructs.DirectedEdge, float64) {
+	return NewBidirectionalDijkstraSP(g).Path(s, t)
+}
+
+// Path finds a shortest path from s to t by running Dijkstra's algorithm
+// forward from s in the digraph and backward from t in its reverse. At each
+// step it settles the closer of the two next vertices, and it stops as soon as
+// the two frontiers together are at least as long as the shortest path seen so
+// far through a vertex reached from both ends. It returns the edges on the
+// path, in order, and the path's length, or nil and positive infinity if there
+// is no such path. It panics if it relaxes an edge with a negative weight.
+func (b *BidirectionalDijkstraSP) Path(s int, t int) ([]datastructs.DirectedEdge, float64) {
+	g, r := b.g, b.r
+	validateVertex(s, g.V)
+	validateVertex(t, g.V)
+
+	type side struct {
+		g    datastructs.EdgeWeightedDigraph
+		tree spt
+		pq   *datastructs.IndexMinPQ[float64]
+	}
+	newSide := func(g datastructs.EdgeWeightedDigraph, s int) *side {
+		sd := &side{g: g, tree: newSPT(g.V, s), pq: datastructs.NewIndexMinPQ[float64](g.V)}
+		sd.pq.Insert(s, 0)
+		return sd
+	}
+	fwd, bwd := newSide(g, s), newSide(r, t)
+
+	best, meet := math.Inf(1), -1
+	if s == t {
+		best, meet = 0, s
+	}
+	for !fwd.pq.IsEmpty() && !bwd.pq.IsEmpty() {
+		if fwd.pq.MinKey()+bwd.pq.MinKey() >= best {
+			break
+		}
+		this, other := fwd, bwd
+		if bwd.pq.MinKey() < fwd.pq.MinKey() {
+			this, other = bwd, fwd
+		}
+		v := this.pq.DelMin()
+		for i := range this.g.Adj[v] {
+			e := &this.g.Adj[v][i]
+			if e.Weight < 0 {
+				panic(fmt.Sprintf("edge %v has negative weight", e))
+			}
+			w := e.To
+			if this.tree.distTo[w] > this.tree.distTo[v]+e.Weight {
+				this.tree.distTo[w] = this.tree.distTo[v] + e.Weight
+				this.tree.edgeTo[w] = e
+				if this.pq.Contains(w) {
+					this.pq.DecreaseKey(w, this.tree.distTo[w])
+				} else {
+					this.pq.Insert(w, this.tree.distTo[w])
+				}
+			}
+			if d := this.tree.distTo[w] + other.tree.distTo[w]; d < best {
+				best, meet = d, w
+			}
+		}
+	}
+	if meet == -1 {
+		return nil, math.Inf(1)
+	}
+
+	// the backward path runs from t to meet in r, so flip it back
+	path := fwd.tree.PathTo(meet)
+	back := bwd.tree.PathTo(meet)
+	for i := len(back) - 1; i >= 0; i-- {
+		e := back[i]
+		path = append(path, datastructs.DirectedEdge{From: e.To, To: e.From, Weight: e.Weight})
+	}
+	return path, best
+}
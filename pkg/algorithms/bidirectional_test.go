@@ -0,0 +1,169 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+func TestBidirectionalBFS(t *testing.T) {
+	g := tinyCG()
+	bfs := NewBreadthFirstPaths(g, 0)
+	for v := 0; v < g.V; v++ {
+		path := BidirectionalBFS(g, 0, v)
+		if len(path)-1 != bfs.DistTo(v) {
+			t.Errorf("expected %v; got %v", bfs.DistTo(v), len(path)-1)
+		}
+		if path[0] != 0 || path[len(path)-1] != v {
+			t.Errorf("expected a path from %v to %v; got %v", 0, v, path)
+		}
+		for i := 0; i < len(path)-1; i++ {
+			if !hasEdge(g.Adj, path[i], path[i+1]) {
+				t.Errorf("path %v uses missing edge %v-%v", path, path[i], path[i+1])
+			}
+		}
+	}
+}
+
+func TestBidirectionalBFSRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		v := 1 + r.Intn(60)
+		g := datastructs.CreateGraph(v)
+		e := r.Intn(2 * v)
+		for j := 0; j < e; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a != b {
+				g.AddEdge(a, b)
+			}
+		}
+		s, target := r.Intn(v), r.Intn(v)
+		bfs := NewBreadthFirstPaths(g, s)
+		path := BidirectionalBFS(g, s, target)
+		if !bfs.HasPathTo(target) {
+			if path != nil {
+				t.Errorf("expected %v; got %v", nil, path)
+			}
+			continue
+		}
+		if len(path)-1 != bfs.DistTo(target) {
+			t.Errorf("%v-%v: expected %v; got %v", s, target, bfs.DistTo(target), len(path)-1)
+		}
+		if path[0] != s || path[len(path)-1] != target {
+			t.Errorf("expected a path from %v to %v; got %v", s, target, path)
+		}
+		for j := 0; j < len(path)-1; j++ {
+			if !hasEdge(g.Adj, path[j], path[j+1]) {
+				t.Errorf("path %v uses missing edge %v-%v", path, path[j], path[j+1])
+			}
+		}
+	}
+}
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	g := tinyEWD()
+	for s := 0; s < g.V; s++ {
+		sp := NewDijkstraSP(g, s)
+		for v := 0; v < g.V; v++ {
+			path, dist := BidirectionalDijkstra(g, s, v)
+			checkP2P(t, sp, s, v, path, dist)
+		}
+	}
+}
+
+func TestBidirectionalDijkstraRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + rnd.Intn(40)
+		g := randomEdgeWeightedDigraph(rnd, v, 2*v, 0, 1)
+		b := NewBidirectionalDijkstraSP(g)
+		s := rnd.Intn(v)
+		sp := NewDijkstraSP(g, s)
+		for target := 0; target < v; target++ {
+			path, dist := b.Path(s, target)
+			checkP2P(t, sp, s, target, path, dist)
+		}
+	}
+}
+
+func TestBidirectionalDijkstraStopsEarly(t *testing.T) {
+	// 0 -> 1 -> 2 is short; everything past 3 is far from both ends and has a
+	// negative edge, which would panic if the search got that far
+	g := edgeWeightedDigraph(6, []datastructs.DirectedEdge{
+		{From: 0, To: 1, Weight: 1},
+		{From: 1, To: 2, Weight: 1},
+		{From: 0, To: 3, Weight: 10},
+		{From: 3, To: 4, Weight: -1},
+		{From: 5, To: 2, Weight: 10},
+		{From: 4, To: 5, Weight: -1},
+	})
+	path, dist := BidirectionalDijkstra(g, 0, 2)
+	if dist != 2 || len(path) != 2 {
+		t.Errorf("expected %v and %v; got %v and %v", 2, 2, dist, len(path))
+	}
+}
+
+func TestBidirectionalDijkstraUnreachable(t *testing.T) {
+	g := edgeWeightedDigraph(3, []datastructs.DirectedEdge{{From: 0, To: 1, Weight: 1}})
+	path, dist := BidirectionalDijkstra(g, 0, 2)
+	if path != nil || !math.IsInf(dist, 1) {
+		t.Errorf("expected %v and %v; got %v and %v", nil, math.Inf(1), path, dist)
+	}
+}
+
+func TestBidirectionalDijkstraSPAfterChange(t *testing.T) {
+	g := edgeWeightedDigraph(3, []datastructs.DirectedEdge{
+		{From: 0, To: 1, Weight: 1},
+		{From: 1, To: 2, Weight: 1},
+	})
+	b := NewBidirectionalDijkstraSP(g)
+	// a shortcut added later mustn't be seen by either search
+	g.AddEdge(datastructs.DirectedEdge{From: 0, To: 2, Weight: 1})
+	path, dist := b.Path(0, 2)
+	if dist != 2 || len(path) != 2 {
+		t.Errorf("expected %v and %v; got %v and %v", 2, 2, dist, path)
+	}
+}
+
+func ExampleBidirectionalBFS() {
+	fmt.Println(BidirectionalBFS(tinyCG(), 1, 5))
+	// Output:
+	// [1 0 5]
+}
+
+func ExampleBidirectionalDijkstra() {
+	path, dist := BidirectionalDijkstra(tinyEWD(), 0, 6)
+	fmt.Printf("%.2f %v\n", dist, path)
+	// Output:
+	// 1.51 [0->2 0.26000 2->7 0.34000 7->3 0.39000 3->6 0.52000]
+}
+
+func ExampleBidirectionalDijkstraSP() {
+	// build the reverse of the digraph once and reuse it for every query
+	b := NewBidirectionalDijkstraSP(tinyEWD())
+	for _, t := range []int{1, 6} {
+		_, dist := b.Path(0, t)
+		fmt.Printf("0 to %v: %.2f\n", t, dist)
+	}
+	// Output:
+	// 0 to 1: 1.05
+	// 0 to 6: 1.51
+}
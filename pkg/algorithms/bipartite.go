@@ -0,0 +1,105 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// Bipartite determines whether a graph is bipartite, i.e. whether its vertices
+// can be coloured with two colours so that every edge joins vertices of
+// different colours. If the graph is bipartite, it finds such a colouring;
+// otherwise it finds an odd-length cycle, which proves it isn't.
+type Bipartite struct {
+	isBipartite bool   // is the graph bipartite?
+	color       []bool // color[v] gives vertices on one side of bipartition
+	marked      []bool // marked[v] = true iff v has been visited in DFS
+	edgeTo      []int  // edgeTo[v] = last edge on path to v
+	cycle       []int  // odd-length cycle
+}
+
+// NewBipartite determines whether the undirected graph g is bipartite, using
+// depth-first search.
+func NewBipartite(g datastructs.Undirected) *Bipartite {
+	return newBipartite(g)
+}
+
+// NewDirectedBipartite determines whether the digraph g is bipartite, ignoring
+// the direction of its edges. An odd cycle it finds may use edges in either
+// direction.
+func NewDirectedBipartite(g datastructs.Adjacency) *Bipartite {
+	return newBipartite(undirected(g))
+}
+
+func newBipartite(g datastructs.Undirected) *Bipartite {
+	n := g.NumVertices()
+	b := &Bipartite{isBipartite: true, color: make([]bool, n), marked: make([]bool, n), edgeTo: make([]int, n)}
+	for v := 0; v < n && b.isBipartite; v++ {
+		if !b.marked[v] {
+			b.dfs(g, v)
+		}
+	}
+	return b
+}
+
+func (b *Bipartite) dfs(g datastructs.Undirected, v int) {
+	b.marked[v] = true
+	for _, w := range g.Adjacent(v) {
+		// short circuit if odd-length cycle found
+		if b.cycle != nil {
+			return
+		}
+		if !b.marked[w] {
+			b.edgeTo[w] = v
+			b.color[w] = !b.color[v]
+			b.dfs(g, w)
+		} else if b.color[w] == b.color[v] {
+			// if v-w creates an odd-length cycle, find it
+			b.isBipartite = false
+			cycle := datastructs.Stack[int]{}
+			cycle.Push(w)
+			for x := v; x != w; x = b.edgeTo[x] {
+				cycle.Push(x)
+			}
+			cycle.Push(w)
+			for !cycle.IsEmpty() {
+				b.cycle = append(b.cycle, cycle.Pop())
+			}
+		}
+	}
+}
+
+// IsBipartite returns true if the graph is bipartite.
+func (b *Bipartite) IsBipartite() bool {
+	return b.isBipartite
+}
+
+// Color returns the side of the bipartition that vertex v is on. Adjacent
+// vertices always have different colours. It panics if the graph isn't
+// bipartite.
+func (b *Bipartite) Color(v int) bool {
+	validateVertex(v, len(b.color))
+	if !b.isBipartite {
+		panic("graph is not bipartite")
+	}
+	return b.color[v]
+}
+
+// OddCycle returns the vertices on an odd-length cycle, or nil if the graph is
+// bipartite. The first and last vertices are the same.
+func (b *Bipartite) OddCycle() []int {
+	return b.cycle
+}
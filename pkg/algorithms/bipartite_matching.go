@@ -0,0 +1,221 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// NotBipartiteError is the error returned when an operation that requires a
+// bipartite graph is given one that isn't.
+type NotBipartiteError struct {
+	// OddCycle holds the vertices on an odd-length cycle, which proves the
+	// graph isn't bipartite. The first and last vertices are the same.
+	OddCycle []int
+}
+
+func (e *NotBipartiteError) Error() string {
+	vertices := make([]string, len(e.OddCycle))
+	for i, v := range e.OddCycle {
+		vertices[i] = fmt.Sprint(v)
+	}
+	return "graph is not bipartite: odd cycle " + strings.Join(vertices, "-")
+}
+
+// BipartiteMatching represents a maximum-cardinality matching in a bipartite
+// graph, i.e. a largest set of edges no two of which share a vertex, along with
+// a minimum vertex cover.
+type BipartiteMatching struct {
+	mate    []int  // mate[v] = w if v-w is an edge in the matching; -1 if v is unmatched
+	size    int    // number of edges in the matching
+	inCover []bool // inCover[v] = is v in the minimum vertex cover?
+}
+
+// hopcroftKarp holds the state of the Hopcroft-Karp algorithm while it runs.
+type hopcroftKarp struct {
+	g     datastructs.Undirected
+	side  *Bipartite
+	mate  []int
+	dist  []int // dist[v] = layer of left vertex v in the current phase, or math.MaxInt
+	free  int   // layer of the left vertices adjacent to the ends of the shortest augmenting paths
+	next  []int // next[v] = index in g.Adjacent(v) of the next edge to try
+	match *BipartiteMatching
+}
+
+// NewHopcroftKarp computes a maximum-cardinality matching in the bipartite
+// graph g, using the Hopcroft-Karp algorithm. In each phase, it finds a maximal
+// set of vertex-disjoint shortest augmenting paths with a breadth-first search
+// followed by depth-first searches. It takes O(E sqrt(V)) time. If g isn't
+// bipartite, it returns a *NotBipartiteError holding an odd cycle.
+func NewHopcroftKarp(g datastructs.Undirected) (*BipartiteMatching, error) {
+	side := NewBipartite(g)
+	if !side.IsBipartite() {
+		return nil, &NotBipartiteError{OddCycle: side.OddCycle()}
+	}
+
+	n := g.NumVertices()
+	m := &BipartiteMatching{mate: make([]int, n), inCover: make([]bool, n)}
+	hk := &hopcroftKarp{g: g, side: side, mate: m.mate, dist: make([]int, n), next: make([]int, n), match: m}
+	for v := 0; v < n; v++ {
+		m.mate[v] = -1
+	}
+	for hk.hasAugmentingPath() {
+		for v := 0; v < n; v++ {
+			hk.next[v] = 0
+		}
+		for v := 0; v < n; v++ {
+			if hk.isLeft(v) && m.mate[v] == -1 && hk.augment(v) {
+				m.size = m.size + 1
+			}
+		}
+	}
+	hk.findCover()
+	return m, nil
+}
+
+// isLeft returns true if v is on the left side of the bipartition. Augmenting
+// paths always start from unmatched left vertices.
+func (hk *hopcroftKarp) isLeft(v int) bool {
+	return !hk.side.Color(v)
+}
+
+// hasAugmentingPath runs a breadth-first search from all unmatched left
+// vertices at once, alternating between unmatched and matched edges, and
+// layers the left vertices by their distance. It stops at the first layer with
+// a neighbour that's an unmatched right vertex, recording it in free, so that
+// only shortest augmenting paths follow the layers. It returns true if it
+// reaches an unmatched right vertex.
+func (hk *hopcroftKarp) hasAugmentingPath() bool {
+	q := datastructs.Queue[int]{}
+	for v := 0; v < hk.g.NumVertices(); v++ {
+		hk.dist[v] = math.MaxInt
+		if hk.isLeft(v) && hk.mate[v] == -1 {
+			hk.dist[v] = 0
+			q.Enqueue(v)
+		}
+	}
+	hk.free = math.MaxInt
+	for !q.IsEmpty() {
+		v := q.Dequeue()
+		if hk.dist[v] >= hk.free {
+			// the shortest augmenting paths have all been layered
+			break
+		}
+		for _, w := range hk.g.Adjacent(v) {
+			u := hk.mate[w]
+			if u == -1 {
+				hk.free = hk.dist[v]
+			} else if hk.dist[u] == math.MaxInt {
+				hk.dist[u] = hk.dist[v] + 1
+				q.Enqueue(u)
+			}
+		}
+	}
+	return hk.free != math.MaxInt
+}
+
+// augment looks for a shortest augmenting path from left vertex v that follows
+// the layers, ending at an unmatched right vertex adjacent to the free layer,
+// and flips the edges along it if there is one.
+func (hk *hopcroftKarp) augment(v int) bool {
+	adj := hk.g.Adjacent(v)
+	for ; hk.next[v] < len(adj); hk.next[v]++ {
+		w := adj[hk.next[v]]
+		u := hk.mate[w]
+		if (u == -1 && hk.dist[v] == hk.free) || (u != -1 && hk.dist[u] == hk.dist[v]+1 && hk.augment(u)) {
+			hk.mate[v] = w
+			hk.mate[w] = v
+			return true
+		}
+	}
+	// no augmenting path through v in this phase
+	hk.dist[v] = math.MaxInt
+	return false
+}
+
+// findCover computes a minimum vertex cover from the maximum matching, using
+// König's theorem. It marks every vertex reachable from an unmatched left
+// vertex by an alternating path; the cover is the unmarked left vertices plus
+// the marked right vertices.
+func (hk *hopcroftKarp) findCover() {
+	marked := make([]bool, hk.g.NumVertices())
+	q := datastructs.Queue[int]{}
+	for v := 0; v < hk.g.NumVertices(); v++ {
+		if hk.isLeft(v) && hk.mate[v] == -1 {
+			marked[v] = true
+			q.Enqueue(v)
+		}
+	}
+	for !q.IsEmpty() {
+		v := q.Dequeue()
+		for _, w := range hk.g.Adjacent(v) {
+			// left to right along unmatched edges, right to left along matched ones
+			if marked[w] || (hk.isLeft(v) == (hk.mate[v] == w)) {
+				continue
+			}
+			marked[w] = true
+			q.Enqueue(w)
+		}
+	}
+	for v := 0; v < hk.g.NumVertices(); v++ {
+		hk.match.inCover[v] = hk.isLeft(v) != marked[v]
+	}
+}
+
+// Mate returns the vertex to which v is matched, or -1 if v is unmatched.
+func (m *BipartiteMatching) Mate(v int) int {
+	validateVertex(v, len(m.mate))
+	return m.mate[v]
+}
+
+// IsMatched returns true if vertex v is matched.
+func (m *BipartiteMatching) IsMatched(v int) bool {
+	return m.Mate(v) != -1
+}
+
+// Size returns the number of edges in the maximum matching.
+func (m *BipartiteMatching) Size() int {
+	return m.size
+}
+
+// IsPerfect returns true if every vertex is matched.
+func (m *BipartiteMatching) IsPerfect() bool {
+	return 2*m.size == len(m.mate)
+}
+
+// InMinVertexCover returns true if vertex v is in the minimum vertex cover.
+// Every edge has at least one endpoint in the cover, and by König's theorem
+// the cover has exactly Size() vertices.
+func (m *BipartiteMatching) InMinVertexCover(v int) bool {
+	validateVertex(v, len(m.inCover))
+	return m.inCover[v]
+}
+
+// MinVertexCover returns the vertices in the minimum vertex cover, in
+// ascending order.
+func (m *BipartiteMatching) MinVertexCover() []int {
+	cover := make([]int, 0, m.size)
+	for v, in := range m.inCover {
+		if in {
+			cover = append(cover, v)
+		}
+	}
+	return cover
+}
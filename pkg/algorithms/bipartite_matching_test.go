@@ -0,0 +1,221 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkMatching verifies that m is a matching in g and that its minimum vertex
+// cover covers every edge and has the same size.
+func checkMatching(t *testing.T, g datastructs.Graph, m *BipartiteMatching) {
+	t.Helper()
+	matched := 0
+	for v := 0; v < g.V; v++ {
+		w := m.Mate(v)
+		if w == -1 {
+			continue
+		}
+		matched = matched + 1
+		if m.Mate(w) != v {
+			t.Errorf("expected Mate(%v) to be %v; got %v", w, v, m.Mate(w))
+		}
+		if !hasEdge(g.Adj, v, w) {
+			t.Errorf("matched edge %v-%v is not in the graph", v, w)
+		}
+	}
+	if matched != 2*m.Size() {
+		t.Errorf("expected %v matched vertices; got %v", 2*m.Size(), matched)
+	}
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if !m.InMinVertexCover(v) && !m.InMinVertexCover(w) {
+				t.Errorf("edge %v-%v is not covered", v, w)
+			}
+		}
+	}
+	if len(m.MinVertexCover()) != m.Size() {
+		t.Errorf("expected a cover of %v vertices; got %v", m.Size(), m.MinVertexCover())
+	}
+}
+
+func TestHopcroftKarp(t *testing.T) {
+	// workers 0-3, jobs 4-7
+	g := datastructs.CreateGraph(8)
+	g.AddEdge(0, 4)
+	g.AddEdge(0, 5)
+	g.AddEdge(1, 4)
+	g.AddEdge(2, 5)
+	g.AddEdge(2, 6)
+	g.AddEdge(3, 6)
+	g.AddEdge(3, 7)
+	m, err := NewHopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Size() != 4 {
+		t.Errorf("expected %v; got %v", 4, m.Size())
+	}
+	if !m.IsPerfect() {
+		t.Errorf("expected %v; got %v", true, m.IsPerfect())
+	}
+	checkMatching(t, g, m)
+}
+
+func TestHopcroftKarpCSRGraph(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := datastructs.NewGraphGenerator(r)
+	for i := 0; i < 10; i++ {
+		g := gen.Bipartite(20+r.Intn(20), 20+r.Intn(20), 60)
+		want, err := NewHopcroftKarp(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got, err := NewHopcroftKarp(datastructs.CreateCSRGraph(g))
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v; got %v", want.mate, got.mate)
+		}
+	}
+}
+
+func TestHopcroftKarpNotPerfect(t *testing.T) {
+	// a star: only one leaf can be matched with the centre
+	g := datastructs.CreateGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	m, err := NewHopcroftKarp(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if m.Size() != 1 {
+		t.Errorf("expected %v; got %v", 1, m.Size())
+	}
+	if m.IsPerfect() {
+		t.Errorf("expected %v; got %v", false, m.IsPerfect())
+	}
+	if m.IsMatched(4) {
+		t.Errorf("expected %v; got %v", false, m.IsMatched(4))
+	}
+	want := []int{0}
+	if len(m.MinVertexCover()) != 1 || m.MinVertexCover()[0] != want[0] {
+		t.Errorf("expected %v; got %v", want, m.MinVertexCover())
+	}
+	checkMatching(t, g, m)
+}
+
+func TestHopcroftKarpNotBipartite(t *testing.T) {
+	g := datastructs.CreateGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	m, err := NewHopcroftKarp(g)
+	if m != nil {
+		t.Errorf("expected %v; got %v", nil, m)
+	}
+	var notBipartite *NotBipartiteError
+	if !errors.As(err, &notBipartite) {
+		t.Fatalf("expected a *NotBipartiteError; got %v", err)
+	}
+	checkCycle(t, g.Adj, notBipartite.OddCycle)
+}
+
+func TestHopcroftKarpShortestPathsOnly(t *testing.T) {
+	// with 1-4 matched, 0-3 is an augmenting path of length 1 and 2-4-1-5 one
+	// of length 3, so a phase must take only the first
+	g := datastructs.CreateGraph(6)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 4)
+	g.AddEdge(2, 4)
+	g.AddEdge(1, 5)
+	n := g.V
+	hk := &hopcroftKarp{g: g, side: NewBipartite(g), mate: []int{-1, 4, -1, -1, 1, -1}, dist: make([]int, n), next: make([]int, n)}
+	if !hk.hasAugmentingPath() {
+		t.Fatalf("expected an augmenting path")
+	}
+	if hk.free != 0 {
+		t.Errorf("expected %v; got %v", 0, hk.free)
+	}
+	if hk.augment(2) {
+		t.Errorf("expected no augmenting path from %v in this phase", 2)
+	}
+	if !hk.augment(0) {
+		t.Errorf("expected an augmenting path from %v", 0)
+	}
+}
+
+func TestHopcroftKarpMatchesMaxFlow(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		left, right := 1+r.Intn(15), 1+r.Intn(15)
+		v := left + right
+		g := datastructs.CreateGraph(v)
+		for j := 0; j < 2*v; j++ {
+			g.AddEdge(r.Intn(left), left+r.Intn(right))
+		}
+		m, err := NewHopcroftKarp(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkMatching(t, g, m)
+
+		// a maximum matching is a maximum flow from a source joined to the
+		// left vertices to a sink joined to the right vertices
+		s, tt := v, v+1
+		fn := datastructs.CreateFlowNetwork(v + 2)
+		for a := 0; a < left; a++ {
+			fn.AddEdge(datastructs.NewFlowEdge(s, a, 1))
+			for _, b := range g.Adj[a] {
+				fn.AddEdge(datastructs.NewFlowEdge(a, b, 1))
+			}
+		}
+		for b := left; b < v; b++ {
+			fn.AddEdge(datastructs.NewFlowEdge(b, tt, 1))
+		}
+		if f := NewFordFulkerson(fn, s, tt); int(f.Value()) != m.Size() {
+			t.Errorf("expected %v; got %v", f.Value(), m.Size())
+		}
+	}
+}
+
+func ExampleBipartiteMatching() {
+	g := datastructs.CreateGraph(6)
+	g.AddEdge(0, 3)
+	g.AddEdge(0, 4)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(2, 5)
+	m, err := NewHopcroftKarp(g)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("size:", m.Size())
+	fmt.Println("cover:", m.MinVertexCover())
+	// Output:
+	// size: 3
+	// cover: [0 1 2]
+}
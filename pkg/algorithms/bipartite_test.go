@@ -0,0 +1,153 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkBipartite verifies the certificate returned by b for the graph with
+// adjacency lists adj: either a valid two-colouring or an odd cycle.
+func checkBipartite(t *testing.T, adj [][]int, b *Bipartite) {
+	t.Helper()
+	if b.IsBipartite() {
+		for v := range adj {
+			for _, w := range adj[v] {
+				if b.Color(v) == b.Color(w) {
+					t.Errorf("edge %v-%v joins vertices of the same colour", v, w)
+				}
+			}
+		}
+		if b.OddCycle() != nil {
+			t.Errorf("expected %v; got %v", nil, b.OddCycle())
+		}
+		return
+	}
+	cycle := b.OddCycle()
+	checkCycle(t, adj, cycle)
+	if (len(cycle)-1)%2 != 1 {
+		t.Errorf("expected an odd cycle; got %v", cycle)
+	}
+}
+
+func TestBipartite(t *testing.T) {
+	testCases := []struct {
+		name        string
+		edges       [][2]int
+		isBipartite bool
+	}{
+		{"path", [][2]int{{0, 1}, {1, 2}, {2, 3}}, true},
+		{"square", [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}}, true},
+		{"triangle", [][2]int{{0, 1}, {1, 2}, {2, 0}}, false},
+		{"pentagon", [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}}, false},
+		{"disconnected", [][2]int{{0, 1}, {2, 3}, {3, 4}, {4, 2}}, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := datastructs.CreateGraph(5)
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1])
+			}
+			b := NewBipartite(g)
+			if b.IsBipartite() != tc.isBipartite {
+				t.Errorf("expected %v; got %v", tc.isBipartite, b.IsBipartite())
+			}
+			checkBipartite(t, g.Adj, b)
+		})
+	}
+}
+
+func TestDirectedBipartite(t *testing.T) {
+	// 0->1, 1->2 and 0->2 form a triangle once direction is ignored
+	g := datastructs.CreateDigraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 2)
+	b := NewDirectedBipartite(g)
+	if b.IsBipartite() {
+		t.Errorf("expected %v; got %v", false, b.IsBipartite())
+	}
+	if len(b.OddCycle()) != 4 {
+		t.Errorf("expected a triangle; got %v", b.OddCycle())
+	}
+
+	g = datastructs.CreateDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 1)
+	g.AddEdge(2, 3)
+	g.AddEdge(0, 3)
+	b = NewDirectedBipartite(g)
+	if !b.IsBipartite() {
+		t.Errorf("expected %v; got %v", true, b.IsBipartite())
+	}
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if b.Color(v) == b.Color(w) {
+				t.Errorf("edge %v->%v joins vertices of the same colour", v, w)
+			}
+		}
+	}
+}
+
+func TestBipartiteRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		v := 2 + r.Intn(20)
+		g := datastructs.CreateGraph(v)
+		// mostly bipartite: edges join even and odd vertices, with an occasional exception
+		for j := 0; j < v; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a == b || ((a+b)%2 == 0 && r.Intn(4) != 0) {
+				continue
+			}
+			g.AddEdge(a, b)
+		}
+		checkBipartite(t, g.Adj, NewBipartite(g))
+	}
+}
+
+func TestBipartiteColorPanics(t *testing.T) {
+	g := datastructs.CreateGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	b := NewBipartite(g)
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected Color to panic on a graph that isn't bipartite")
+		}
+	}()
+	b.Color(0)
+}
+
+func ExampleBipartite() {
+	g := datastructs.CreateGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	g.AddEdge(4, 0)
+	b := NewBipartite(g)
+	fmt.Println(b.IsBipartite())
+	fmt.Println(b.OddCycle())
+	// Output:
+	// false
+	// [0 1 2 3 4 0]
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// BreadthFirstPaths finds shortest paths (i.e. paths with the fewest edges)
+// from a source vertex s to every other vertex in a graph, using breadth-first
+// search.
+type BreadthFirstPaths struct {
+	s      int    // source vertex
+	marked []bool // marked[v] = is there an s-v path?
+	edgeTo []int  // edgeTo[v] = previous vertex on a shortest s-v path
+	distTo []int  // distTo[v] = number of edges on a shortest s-v path
+}
+
+// NewBreadthFirstPaths runs a breadth-first search on graph g from vertex s and
+// records the parent links and distances needed to reconstruct shortest paths
+// from s.
+func NewBreadthFirstPaths(g datastructs.Adjacency, s int) *BreadthFirstPaths {
+	validateVertex(s, g.NumVertices())
+	p := &BreadthFirstPaths{s: s, marked: make([]bool, g.NumVertices()), edgeTo: make([]int, g.NumVertices()), distTo: make([]int, g.NumVertices())}
+	for v := 0; v < g.NumVertices(); v++ {
+		p.distTo[v] = -1
+	}
+	p.bfs(g, s)
+	return p
+}
+
+func (p *BreadthFirstPaths) bfs(g datastructs.Adjacency, s int) {
+	q := datastructs.Queue[int]{}
+	p.marked[s] = true
+	p.distTo[s] = 0
+	q.Enqueue(s)
+
+	for !q.IsEmpty() {
+		v := q.Dequeue()
+		for _, w := range g.Adjacent(v) {
+			if !p.marked[w] {
+				p.edgeTo[w] = v
+				p.distTo[w] = p.distTo[v] + 1
+				p.marked[w] = true
+				q.Enqueue(w)
+			}
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and v.
+func (p *BreadthFirstPaths) HasPathTo(v int) bool {
+	validateVertex(v, len(p.marked))
+	return p.marked[v]
+}
+
+// DistTo returns the number of edges on a shortest path between the source
+// vertex and v, or -1 if there is no such path.
+func (p *BreadthFirstPaths) DistTo(v int) int {
+	validateVertex(v, len(p.marked))
+	return p.distTo[v]
+}
+
+// PathTo returns the vertices on a shortest path from the source vertex to v,
+// starting with the source and ending with v, or nil if there is no such path.
+func (p *BreadthFirstPaths) PathTo(v int) []int {
+	if !p.HasPathTo(v) {
+		return nil
+	}
+	return pathTo(p.edgeTo, p.s, v)
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+func TestBreadthFirstPaths(t *testing.T) {
+	p := NewBreadthFirstPaths(tinyCG(), 0)
+	testCases := []struct {
+		name string
+		v    int
+		dist int
+		path []int
+	}{
+		{"0", 0, 0, []int{0}},
+		{"1", 1, 1, []int{0, 1}},
+		{"2", 2, 1, []int{0, 2}},
+		{"3", 3, 2, []int{0, 5, 3}},
+		{"4", 4, 2, []int{0, 2, 4}},
+		{"5", 5, 1, []int{0, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !p.HasPathTo(tc.v) {
+				t.Errorf("expected %v; got %v", true, p.HasPathTo(tc.v))
+			}
+			if p.DistTo(tc.v) != tc.dist {
+				t.Errorf("expected %v; got %v", tc.dist, p.DistTo(tc.v))
+			}
+			if !reflect.DeepEqual(p.PathTo(tc.v), tc.path) {
+				t.Errorf("expected %v; got %v", tc.path, p.PathTo(tc.v))
+			}
+		})
+	}
+}
+
+func TestBreadthFirstPathsUnreachable(t *testing.T) {
+	g := datastructs.CreateGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 3)
+	p := NewBreadthFirstPaths(g, 0)
+	if p.HasPathTo(3) {
+		t.Errorf("expected %v; got %v", false, p.HasPathTo(3))
+	}
+	if p.DistTo(3) != -1 {
+		t.Errorf("expected %v; got %v", -1, p.DistTo(3))
+	}
+	if p.PathTo(3) != nil {
+		t.Errorf("expected %v; got %v", nil, p.PathTo(3))
+	}
+}
+
+func ExampleBreadthFirstPaths() {
+	p := NewBreadthFirstPaths(tinyCG(), 0)
+	for v := 0; v < 6; v++ {
+		fmt.Printf("%v (%v): %v\n", v, p.DistTo(v), p.PathTo(v))
+	}
+	// Output:
+	// 0 (0): [0]
+	// 1 (1): [0 1]
+	// 2 (1): [0 2]
+	// 3 (2): [0 5 3]
+	// 4 (2): [0 2 4]
+	// 5 (1): [0 5]
+}
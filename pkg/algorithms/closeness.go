@@ -0,0 +1,51 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// ClosenessCentrality computes the closeness centrality of every vertex in
+// graph g: the reciprocal of the average distance from the vertex to the
+// vertices it can reach, found by a breadth-first search from each vertex. So
+// that vertices in small components don't score highly just for being close
+// to their few neighbours, the score is scaled by the fraction of the other
+// vertices that are reachable (the Wasserman-Faust formula):
+//
+//	closeness(v) = (r - 1) / sum * (r - 1) / (V - 1)
+//
+// where r is the number of vertices reachable from v, including v, and sum is
+// the total distance to them. A vertex that can't reach any other scores 0.
+// In a Digraph, distances are measured along edges out of v. It takes O(EV)
+// time.
+func ClosenessCentrality(g datastructs.Adjacency) []float64 {
+	n := g.NumVertices()
+	score := make([]float64, n)
+	paths := newPathCounts(n)
+	for v := 0; v < n; v++ {
+		paths.bfs(g, v)
+		// order[0] is v itself
+		reached, sum := len(paths.order)-1, 0
+		for _, w := range paths.order[1:] {
+			sum = sum + paths.dist[w]
+		}
+		if sum > 0 {
+			score[v] = float64(reached) / float64(sum) * float64(reached) / float64(n-1)
+		}
+	}
+	return score
+}
@@ -0,0 +1,79 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+func TestClosenessCentrality(t *testing.T) {
+	path := datastructs.CreateGraph(5)
+	for v := 0; v < 4; v++ {
+		path.AddEdge(v, v+1)
+	}
+	// 2 is isolated
+	split := datastructs.CreateGraph(3)
+	split.AddEdge(0, 1)
+	chain := datastructs.CreateDigraph(3)
+	chain.AddEdge(0, 1)
+	chain.AddEdge(1, 2)
+	testCases := []struct {
+		name string
+		g    datastructs.Adjacency
+		want []float64
+	}{
+		{"path", path, []float64{0.4, 4.0 / 7, 4.0 / 6, 4.0 / 7, 0.4}},
+		{"disconnected", split, []float64{0.5, 0.5, 0}},
+		{"digraph", chain, []float64{2.0 / 3, 0.5, 0}},
+		{"single", datastructs.CreateGraph(1), []float64{0}},
+		{"empty", datastructs.CreateGraph(0), []float64{}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ClosenessCentrality(tc.g)
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected %v; got %v", tc.want, got)
+			}
+			for v := range got {
+				if math.Abs(got[v]-tc.want[v]) > 1e-9 {
+					t.Errorf("expected %v; got %v", tc.want, got)
+					break
+				}
+			}
+		})
+	}
+}
+
+func ExampleClosenessCentrality() {
+	// a star: vertex 0 is one edge from every other vertex
+	g := datastructs.CreateGraph(5)
+	for v := 1; v < 5; v++ {
+		g.AddEdge(0, v)
+	}
+	for v, score := range ClosenessCentrality(g) {
+		fmt.Printf("%v %.3f\n", v, score)
+	}
+	// Output:
+	// 0 1.000
+	// 1 0.571
+	// 2 0.571
+	// 3 0.571
+	// 4 0.571
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// ConnectedComponents computes the connected components of an undirected graph.
+// Components are numbered 0 through Count() – 1 in the order in which their
+// lowest-numbered vertex appears.
+type ConnectedComponents struct {
+	marked []bool // marked[v] = has vertex v been marked?
+	id     []int  // id[v] = id of connected component containing v
+	size   []int  // size[id] = number of vertices in given component
+	count  int    // number of connected components
+}
+
+// NewConnectedComponents computes the connected components of the undirected
+// graph g by running a depth-first search from every vertex that isn't yet
+// marked. For the strong components of a digraph, see SCC.
+func NewConnectedComponents(g datastructs.Undirected) *ConnectedComponents {
+	cc := &ConnectedComponents{marked: make([]bool, g.NumVertices()), id: make([]int, g.NumVertices())}
+	for s := 0; s < g.NumVertices(); s++ {
+		if cc.marked[s] {
+			continue
+		}
+		cc.size = append(cc.size, 0)
+		dfsIterative(g, s, cc.marked, func(v int) {
+			cc.id[v] = cc.count
+			cc.size[cc.count] = cc.size[cc.count] + 1
+		})
+		cc.count = cc.count + 1
+	}
+	return cc
+}
+
+// Count returns the number of connected components.
+func (cc *ConnectedComponents) Count() int {
+	return cc.count
+}
+
+// ID returns the component id of the connected component containing vertex v.
+func (cc *ConnectedComponents) ID(v int) int {
+	validateVertex(v, len(cc.id))
+	return cc.id[v]
+}
+
+// Size returns the number of vertices in the connected component containing vertex v.
+func (cc *ConnectedComponents) Size(v int) int {
+	return cc.size[cc.ID(v)]
+}
+
+// Connected returns true if vertices v and w are in the same connected component.
+func (cc *ConnectedComponents) Connected(v int, w int) bool {
+	return cc.ID(v) == cc.ID(w)
+}
+
+// Components returns the vertices in each connected component, indexed by
+// component id. The vertices of each component are in ascending order.
+func (cc *ConnectedComponents) Components() [][]int {
+	components := make([][]int, cc.count)
+	for i := 0; i < cc.count; i++ {
+		components[i] = make([]int, 0, cc.size[i])
+	}
+	for v, id := range cc.id {
+		components[id] = append(components[id], v)
+	}
+	return components
+}
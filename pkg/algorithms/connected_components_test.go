@@ -0,0 +1,131 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyG returns the graph from tinyG.txt in algs4.
+func tinyG() datastructs.Graph {
+	g := datastructs.CreateGraph(13)
+	g.AddEdge(0, 5)
+	g.AddEdge(4, 3)
+	g.AddEdge(0, 1)
+	g.AddEdge(9, 12)
+	g.AddEdge(6, 4)
+	g.AddEdge(5, 4)
+	g.AddEdge(0, 2)
+	g.AddEdge(11, 12)
+	g.AddEdge(9, 10)
+	g.AddEdge(0, 6)
+	g.AddEdge(7, 8)
+	g.AddEdge(9, 11)
+	g.AddEdge(5, 3)
+	return g
+}
+
+func TestConnectedComponents(t *testing.T) {
+	cc := NewConnectedComponents(tinyG())
+	if cc.Count() != 3 {
+		t.Errorf("expected %v; got %v", 3, cc.Count())
+	}
+	want := [][]int{{0, 1, 2, 3, 4, 5, 6}, {7, 8}, {9, 10, 11, 12}}
+	if !reflect.DeepEqual(cc.Components(), want) {
+		t.Errorf("expected %v; got %v", want, cc.Components())
+	}
+
+	testCases := []struct {
+		name      string
+		v         int
+		w         int
+		connected bool
+	}{
+		{"t1", 0, 4, true},
+		{"t2", 0, 7, false},
+		{"t3", 7, 8, true},
+		{"t4", 12, 9, true},
+		{"t5", 12, 6, false},
+		{"t6", 3, 3, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if cc.Connected(tc.v, tc.w) != tc.connected {
+				t.Errorf("expected %v; got %v", tc.connected, cc.Connected(tc.v, tc.w))
+			}
+		})
+	}
+
+	if cc.Size(10) != 4 {
+		t.Errorf("expected %v; got %v", 4, cc.Size(10))
+	}
+}
+
+func TestConnectedComponentsMatchesUnionFind(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		v := 1 + r.Intn(60)
+		g := datastructs.CreateGraph(v)
+		uf := datastructs.NewUnionFind(v)
+		// vary the density so there's a mix of sparse and connected graphs
+		e := r.Intn(v + 1)
+		for j := 0; j < e; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a == b {
+				continue
+			}
+			g.AddEdge(a, b)
+			uf.Union(a, b)
+		}
+
+		cc := NewConnectedComponents(g)
+		if cc.Count() != uf.Count() {
+			t.Errorf("expected %v; got %v", uf.Count(), cc.Count())
+		}
+		for a := 0; a < v; a++ {
+			for b := 0; b < v; b++ {
+				if cc.Connected(a, b) != uf.Connected(a, b) {
+					t.Errorf("expected Connected(%v, %v) to be %v; got %v", a, b, uf.Connected(a, b), cc.Connected(a, b))
+				}
+			}
+		}
+		total := 0
+		for _, c := range cc.Components() {
+			total = total + len(c)
+		}
+		if total != v {
+			t.Errorf("expected %v; got %v", v, total)
+		}
+	}
+}
+
+func ExampleConnectedComponents() {
+	cc := NewConnectedComponents(tinyG())
+	fmt.Println(cc.Count(), "components")
+	for _, c := range cc.Components() {
+		fmt.Println(c)
+	}
+	// Output:
+	// 3 components
+	// [0 1 2 3 4 5 6]
+	// [7 8]
+	// [9 10 11 12]
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// Cycle determines whether a graph or digraph has a cycle and, if so, finds one.
+type Cycle struct {
+	marked  []bool
+	edgeTo  []int  // edgeTo[v] = previous vertex on path to v
+	onStack []bool // onStack[v] = is vertex v on the stack? (digraphs only)
+	cycle   []int  // cycle, or nil if there is no cycle
+}
+
+// NewCycle finds a cycle in the undirected graph g, if there is one, using
+// depth-first search.
+func NewCycle(g datastructs.Undirected) *Cycle {
+	c := &Cycle{marked: make([]bool, g.NumVertices()), edgeTo: make([]int, g.NumVertices())}
+	for v := 0; v < g.NumVertices() && c.cycle == nil; v++ {
+		if !c.marked[v] {
+			c.dfs(g, -1, v)
+		}
+	}
+	return c
+}
+
+// dfs searches for a cycle from v, which was reached from u. Since g is
+// undirected, the edge back to u doesn't count as a cycle.
+func (c *Cycle) dfs(g datastructs.Undirected, u int, v int) {
+	c.marked[v] = true
+	for _, w := range g.Adjacent(v) {
+		if c.cycle != nil {
+			return
+		}
+		if !c.marked[w] {
+			c.edgeTo[w] = v
+			c.dfs(g, v, w)
+		} else if w != u {
+			c.cycle = c.trace(v, w)
+		}
+	}
+}
+
+// NewDirectedCycle finds a directed cycle in the digraph g, if there is one,
+// using depth-first search.
+func NewDirectedCycle(g datastructs.Adjacency) *Cycle {
+	c := &Cycle{marked: make([]bool, g.NumVertices()), edgeTo: make([]int, g.NumVertices()), onStack: make([]bool, g.NumVertices())}
+	for v := 0; v < g.NumVertices() && c.cycle == nil; v++ {
+		if !c.marked[v] {
+			c.directedDFS(g, v)
+		}
+	}
+	return c
+}
+
+func (c *Cycle) directedDFS(g datastructs.Adjacency, v int) {
+	c.onStack[v] = true
+	c.marked[v] = true
+	for _, w := range g.Adjacent(v) {
+		if c.cycle != nil {
+			return
+		}
+		if !c.marked[w] {
+			c.edgeTo[w] = v
+			c.directedDFS(g, w)
+		} else if c.onStack[w] {
+			c.cycle = c.trace(v, w)
+		}
+	}
+	c.onStack[v] = false
+}
+
+// trace returns the cycle closed by the edge v-w, where w is an ancestor of v
+// in the depth-first search tree. The cycle starts and ends with v.
+func (c *Cycle) trace(v int, w int) []int {
+	cycle := datastructs.Stack[int]{}
+	for x := v; x != w; x = c.edgeTo[x] {
+		cycle.Push(x)
+	}
+	cycle.Push(w)
+	cycle.Push(v)
+	vertices := make([]int, 0, cycle.Size())
+	for !cycle.IsEmpty() {
+		vertices = append(vertices, cycle.Pop())
+	}
+	return vertices
+}
+
+// HasCycle returns true if the graph has a cycle.
+func (c *Cycle) HasCycle() bool {
+	return c.cycle != nil
+}
+
+// Cycle returns the vertices on a cycle, or nil if the graph has no cycle. The
+// first and last vertices are the same. For a digraph, the vertices are in the
+// direction of the edges.
+func (c *Cycle) Cycle() []int {
+	return c.cycle
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// hasEdge returns true if w is in adj[v].
+func hasEdge(adj [][]int, v int, w int) bool {
+	for _, x := range adj[v] {
+		if x == w {
+			return true
+		}
+	}
+	return false
+}
+
+// checkCycle verifies that cycle is a closed walk along the edges in adj.
+func checkCycle(t *testing.T, adj [][]int, cycle []int) {
+	t.Helper()
+	if len(cycle) < 2 || cycle[0] != cycle[len(cycle)-1] {
+		t.Errorf("expected %v to start and end with the same vertex", cycle)
+		return
+	}
+	for i := 0; i < len(cycle)-1; i++ {
+		if !hasEdge(adj, cycle[i], cycle[i+1]) {
+			t.Errorf("cycle %v uses missing edge %v-%v", cycle, cycle[i], cycle[i+1])
+		}
+	}
+}
+
+func TestCycle(t *testing.T) {
+	g := tinyG()
+	c := NewCycle(g)
+	if !c.HasCycle() {
+		t.Fatalf("expected %v; got %v", true, c.HasCycle())
+	}
+	checkCycle(t, g.Adj, c.Cycle())
+	if len(c.Cycle()) < 4 {
+		t.Errorf("expected a cycle of at least 3 edges; got %v", c.Cycle())
+	}
+}
+
+func TestCycleForest(t *testing.T) {
+	g := datastructs.CreateGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(4, 5)
+	c := NewCycle(g)
+	if c.HasCycle() {
+		t.Errorf("expected %v; got %v", false, c.HasCycle())
+	}
+	if c.Cycle() != nil {
+		t.Errorf("expected %v; got %v", nil, c.Cycle())
+	}
+}
+
+func TestDirectedCycle(t *testing.T) {
+	testCases := []struct {
+		name     string
+		edges    [][2]int
+		hasCycle bool
+	}{
+		{"dag", [][2]int{{0, 1}, {0, 2}, {1, 2}, {2, 3}}, false},
+		{"two-cycle", [][2]int{{0, 1}, {1, 0}}, true},
+		{"self loop", [][2]int{{0, 1}, {1, 1}}, true},
+		// 0->1->2 and 0->2 form a cycle in the underlying graph, but not a directed one
+		{"diamond", [][2]int{{0, 1}, {1, 2}, {0, 2}, {2, 3}}, false},
+		{"long", [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 1}}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := datastructs.CreateDigraph(5)
+			for _, e := range tc.edges {
+				g.AddEdge(e[0], e[1])
+			}
+			c := NewDirectedCycle(g)
+			if c.HasCycle() != tc.hasCycle {
+				t.Errorf("expected %v; got %v", tc.hasCycle, c.HasCycle())
+			}
+			if c.HasCycle() {
+				checkCycle(t, g.Adj, c.Cycle())
+			}
+		})
+	}
+}
+
+func TestDirectedCycleRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		v := 1 + r.Intn(20)
+		g := datastructs.CreateDigraph(v)
+		for j := 0; j < v; j++ {
+			g.AddEdge(r.Intn(v), r.Intn(v))
+		}
+		c := NewDirectedCycle(g)
+		if c.HasCycle() {
+			checkCycle(t, g.Adj, c.Cycle())
+		}
+	}
+}
+
+func ExampleCycle() {
+	g := datastructs.CreateDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 1)
+	c := NewDirectedCycle(g)
+	fmt.Println(c.Cycle())
+	// Output:
+	// [3 1 2 3]
+}
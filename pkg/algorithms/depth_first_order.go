@@ -0,0 +1,97 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// DepthFirstOrder computes the preorder and postorder of a digraph, i.e. the
+// order in which a depth-first search first reaches, and finishes with, each
+// vertex. The search starts from each unmarked vertex in ascending order.
+type DepthFirstOrder struct {
+	marked    []bool // marked[v] = has v been marked in dfs?
+	pre       []int  // pre[v] = preorder number of v
+	post      []int  // post[v] = postorder number of v
+	preorder  []int  // vertices in preorder
+	postorder []int  // vertices in postorder
+}
+
+// NewDepthFirstOrder computes the depth-first orders of digraph g.
+func NewDepthFirstOrder(g datastructs.Adjacency) *DepthFirstOrder {
+	o := &DepthFirstOrder{
+		marked:    make([]bool, g.NumVertices()),
+		pre:       make([]int, g.NumVertices()),
+		post:      make([]int, g.NumVertices()),
+		preorder:  make([]int, 0, g.NumVertices()),
+		postorder: make([]int, 0, g.NumVertices()),
+	}
+	for v := 0; v < g.NumVertices(); v++ {
+		if !o.marked[v] {
+			o.dfs(g, v)
+		}
+	}
+	return o
+}
+
+func (o *DepthFirstOrder) dfs(g datastructs.Adjacency, v int) {
+	o.marked[v] = true
+	o.pre[v] = len(o.preorder)
+	o.preorder = append(o.preorder, v)
+	for _, w := range g.Adjacent(v) {
+		if !o.marked[w] {
+			o.dfs(g, w)
+		}
+	}
+	o.post[v] = len(o.postorder)
+	o.postorder = append(o.postorder, v)
+}
+
+// Pre returns the preorder number of vertex v.
+func (o *DepthFirstOrder) Pre(v int) int {
+	validateVertex(v, len(o.pre))
+	return o.pre[v]
+}
+
+// Post returns the postorder number of vertex v.
+func (o *DepthFirstOrder) Post(v int) int {
+	validateVertex(v, len(o.post))
+	return o.post[v]
+}
+
+// PreOrder returns the vertices in preorder.
+func (o *DepthFirstOrder) PreOrder() []int {
+	return o.preorder
+}
+
+// PostOrder returns the vertices in postorder.
+func (o *DepthFirstOrder) PostOrder() []int {
+	return o.postorder
+}
+
+// ReversePostOrder returns the vertices in reverse postorder. If the digraph
+// is acyclic, this is a topological order.
+func (o *DepthFirstOrder) ReversePostOrder() []int {
+	reverse := datastructs.Stack[int]{}
+	for _, v := range o.postorder {
+		reverse.Push(v)
+	}
+	order := make([]int, 0, reverse.Size())
+	for !reverse.IsEmpty() {
+		order = append(order, reverse.Pop())
+	}
+	return order
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyDAG returns the DAG from tinyDAG.txt in algs4.
+func tinyDAG() datastructs.Digraph {
+	g := datastructs.CreateDigraph(13)
+	g.AddEdge(2, 3)
+	g.AddEdge(0, 6)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 0)
+	g.AddEdge(11, 12)
+	g.AddEdge(9, 12)
+	g.AddEdge(9, 10)
+	g.AddEdge(9, 11)
+	g.AddEdge(3, 5)
+	g.AddEdge(8, 7)
+	g.AddEdge(5, 4)
+	g.AddEdge(0, 5)
+	g.AddEdge(6, 4)
+	g.AddEdge(6, 9)
+	g.AddEdge(7, 6)
+	return g
+}
+
+func TestDepthFirstOrder(t *testing.T) {
+	o := NewDepthFirstOrder(tinyDAG())
+	wantPre := []int{0, 6, 4, 9, 12, 10, 11, 1, 5, 2, 3, 7, 8}
+	wantPost := []int{4, 12, 10, 11, 9, 6, 1, 5, 0, 3, 2, 7, 8}
+	if !reflect.DeepEqual(o.PreOrder(), wantPre) {
+		t.Errorf("expected %v; got %v", wantPre, o.PreOrder())
+	}
+	if !reflect.DeepEqual(o.PostOrder(), wantPost) {
+		t.Errorf("expected %v; got %v", wantPost, o.PostOrder())
+	}
+	for i, v := range wantPre {
+		if o.Pre(v) != i {
+			t.Errorf("expected %v; got %v", i, o.Pre(v))
+		}
+	}
+	for i, v := range wantPost {
+		if o.Post(v) != i {
+			t.Errorf("expected %v; got %v", i, o.Post(v))
+		}
+	}
+	reversePost := o.ReversePostOrder()
+	for i, v := range reversePost {
+		if wantPost[len(wantPost)-1-i] != v {
+			t.Errorf("expected %v; got %v", wantPost[len(wantPost)-1-i], v)
+		}
+	}
+}
+
+func ExampleDepthFirstOrder() {
+	g := datastructs.CreateDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+	o := NewDepthFirstOrder(g)
+	fmt.Println(o.PreOrder())
+	fmt.Println(o.PostOrder())
+	fmt.Println(o.ReversePostOrder())
+	// Output:
+	// [0 1 2 3]
+	// [1 3 2 0]
+	// [0 2 3 1]
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// DepthFirstPaths finds paths from a source vertex s to every other vertex in
+// a graph, using depth-first search. The paths are not necessarily shortest.
+type DepthFirstPaths struct {
+	s      int    // source vertex
+	marked []bool // marked[v] = is there an s-v path?
+	edgeTo []int  // edgeTo[v] = previous vertex on the s-v path
+}
+
+// NewDepthFirstPaths runs a depth-first search on graph g from vertex s and
+// records the parent links needed to reconstruct paths from s.
+func NewDepthFirstPaths(g datastructs.Adjacency, s int) *DepthFirstPaths {
+	validateVertex(s, g.NumVertices())
+	p := &DepthFirstPaths{s: s, marked: make([]bool, g.NumVertices()), edgeTo: make([]int, g.NumVertices())}
+	p.dfs(g, s)
+	return p
+}
+
+func (p *DepthFirstPaths) dfs(g datastructs.Adjacency, v int) {
+	p.marked[v] = true
+	for _, w := range g.Adjacent(v) {
+		if !p.marked[w] {
+			p.edgeTo[w] = v
+			p.dfs(g, w)
+		}
+	}
+}
+
+// HasPathTo returns true if there is a path between the source vertex and v.
+func (p *DepthFirstPaths) HasPathTo(v int) bool {
+	validateVertex(v, len(p.marked))
+	return p.marked[v]
+}
+
+// PathTo returns the vertices on a path from the source vertex to v, starting
+// with the source and ending with v, or nil if there is no such path.
+func (p *DepthFirstPaths) PathTo(v int) []int {
+	if !p.HasPathTo(v) {
+		return nil
+	}
+	return pathTo(p.edgeTo, p.s, v)
+}
+
+// pathTo follows the parent links in edgeTo back from v to s and returns the
+// vertices in order from s to v.
+func pathTo(edgeTo []int, s int, v int) []int {
+	path := datastructs.Stack[int]{}
+	for x := v; x != s; x = edgeTo[x] {
+		path.Push(x)
+	}
+	path.Push(s)
+	vertices := make([]int, 0, path.Size())
+	for !path.IsEmpty() {
+		vertices = append(vertices, path.Pop())
+	}
+	return vertices
+}
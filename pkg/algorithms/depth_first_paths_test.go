@@ -0,0 +1,84 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyCG returns the graph from tinyCG.txt in algs4.
+func tinyCG() datastructs.Graph {
+	g := datastructs.CreateGraph(6)
+	g.AddEdge(0, 5)
+	g.AddEdge(2, 4)
+	g.AddEdge(2, 3)
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 1)
+	g.AddEdge(3, 4)
+	g.AddEdge(3, 5)
+	g.AddEdge(0, 2)
+	return g
+}
+
+func TestDepthFirstPaths(t *testing.T) {
+	p := NewDepthFirstPaths(tinyCG(), 0)
+	testCases := []struct {
+		name string
+		v    int
+		path []int
+	}{
+		{"0", 0, []int{0}},
+		{"1", 1, []int{0, 5, 3, 2, 1}},
+		{"2", 2, []int{0, 5, 3, 2}},
+		{"3", 3, []int{0, 5, 3}},
+		{"4", 4, []int{0, 5, 3, 2, 4}},
+		{"5", 5, []int{0, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !p.HasPathTo(tc.v) {
+				t.Errorf("expected %v; got %v", true, p.HasPathTo(tc.v))
+			}
+			if !reflect.DeepEqual(p.PathTo(tc.v), tc.path) {
+				t.Errorf("expected %v; got %v", tc.path, p.PathTo(tc.v))
+			}
+		})
+	}
+}
+
+func TestDepthFirstPathsUnreachable(t *testing.T) {
+	g := datastructs.CreateGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 3)
+	p := NewDepthFirstPaths(g, 0)
+	if p.HasPathTo(3) {
+		t.Errorf("expected %v; got %v", false, p.HasPathTo(3))
+	}
+	if p.PathTo(3) != nil {
+		t.Errorf("expected %v; got %v", nil, p.PathTo(3))
+	}
+}
+
+func ExampleDepthFirstPaths() {
+	p := NewDepthFirstPaths(tinyCG(), 0)
+	fmt.Println(p.PathTo(4))
+	// Output:
+	// [0 5 3 2 4]
+}
@@ -31,20 +31,59 @@ func validateVertex(v int, V int) {
 	}
 }
 
-func dfs(g datastructs.Graph, v int, marked []bool, cb Proc) {
+func dfs(g datastructs.Adjacency, v int, marked []bool, cb Proc) {
 	marked[v] = true
 	cb(v)
-	for _, w := range g.Adj[v] {
+	for _, w := range g.Adjacent(v) {
 		if !marked[w] {
 			dfs(g, w, marked, cb)
 		}
 	}
 }
 
+// dfsIterative is equivalent to dfs, but it keeps the vertices on the current
+// path on an explicit stack instead of the call stack, so it can traverse
+// arbitrarily deep graphs. It visits the vertices in the same order as dfs.
+func dfsIterative(g datastructs.Adjacency, s int, marked []bool, cb Proc) {
+	next := make([]int, g.NumVertices()) // next[v] = index in g.Adjacent(v) of the next neighbour to consider
+	stack := datastructs.Stack[int]{}
+	marked[s] = true
+	cb(s)
+	stack.Push(s)
+	for !stack.IsEmpty() {
+		v := stack.Peek()
+		adj := g.Adjacent(v)
+		if next[v] == len(adj) {
+			// all of v's neighbours have been considered, so backtrack
+			stack.Pop()
+			continue
+		}
+		w := adj[next[v]]
+		next[v] = next[v] + 1
+		if !marked[w] {
+			marked[w] = true
+			cb(w)
+			stack.Push(w)
+		}
+	}
+}
+
 // DFS performs a depth-first search on graph g, starting at vertex s. It invokes
-// a callback function on each discovered vertex.
-func DFS(g datastructs.Graph, s int, cb Proc) {
-	marked := make([]bool, g.V)
-	validateVertex(s, g.V)
+// a callback function on each discovered vertex. g can be any graph
+// representation, such as a Graph, a CSRGraph, or a Digraph, in which case the
+// search follows edges in their direction.
+func DFS(g datastructs.Adjacency, s int, cb Proc) {
+	marked := make([]bool, g.NumVertices())
+	validateVertex(s, g.NumVertices())
 	dfs(g, s, marked, cb)
 }
+
+// DFSIterative performs a depth-first search on graph g, starting at vertex s.
+// It invokes a callback function on each discovered vertex, in the same order
+// as DFS. Unlike DFS, it doesn't recurse, so it's safe to use on graphs with
+// very long paths. Like DFS, it accepts any graph representation.
+func DFSIterative(g datastructs.Adjacency, s int, cb Proc) {
+	marked := make([]bool, g.NumVertices())
+	validateVertex(s, g.NumVertices())
+	dfsIterative(g, s, marked, cb)
+}
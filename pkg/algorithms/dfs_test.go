@@ -17,6 +17,8 @@ package algorithms
 
 import (
 	"fmt"
+	"math/rand"
+	"reflect"
 	"testing"
 
 	"github.com/pcoet/golang-patterns/pkg/datastructs"
@@ -49,6 +51,103 @@ func TestDFS(t *testing.T) {
 	}
 }
 
+func TestDFSDigraph(t *testing.T) {
+	g := datastructs.CreateDigraph(6)
+	g.AddEdge(0, 5)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 5)
+	g.AddEdge(3, 2)
+	g.AddEdge(4, 3)
+	g.AddEdge(4, 2)
+	g.AddEdge(5, 4)
+
+	testCases := []struct {
+		name string
+		s    int
+		want []int
+	}{
+		{"t1", 0, []int{0, 5, 4, 3, 2, 1}},
+		{"t2", 1, []int{1}},
+		{"t3", 2, []int{2, 0, 5, 4, 3, 1}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []int
+			DFS(g, tc.s, func(v int) {
+				got = append(got, v)
+			})
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v; got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestDFSIterative(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(50)
+		g := datastructs.CreateGraph(v)
+		d := datastructs.CreateDigraph(v)
+		for j := 0; j < 2*v; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a != b {
+				g.AddEdge(a, b)
+			}
+			d.AddEdge(a, b)
+		}
+		s := r.Intn(v)
+
+		var want, got []int
+		DFS(g, s, func(v int) { want = append(want, v) })
+		DFSIterative(g, s, func(v int) { got = append(got, v) })
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v; got %v", want, got)
+		}
+
+		want, got = nil, nil
+		DFS(d, s, func(v int) { want = append(want, v) })
+		DFSIterative(d, s, func(v int) { got = append(got, v) })
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v; got %v", want, got)
+		}
+	}
+}
+
+func TestDFSIterativeDeepGraph(t *testing.T) {
+	// a path this long would need a very large call stack with DFS
+	n := 1 << 20
+	g := datastructs.CreateGraph(n)
+	for v := 0; v < n-1; v++ {
+		g.AddEdge(v, v+1)
+	}
+	count := 0
+	last := -1
+	DFSIterative(g, 0, func(v int) {
+		count = count + 1
+		last = v
+	})
+	if count != n {
+		t.Errorf("expected %v; got %v", n, count)
+	}
+	if last != n-1 {
+		t.Errorf("expected %v; got %v", n-1, last)
+	}
+}
+
+func TestDFSCSRGraph(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	for _, g := range []datastructs.Graph{gen.Grid(20, 30), gen.Simple(500, 800), gen.PreferentialAttachment(500, 3)} {
+		want := visitOrder(DFS, g, 0)
+		got := visitOrder(DFS, datastructs.CreateCSRGraph(g), 0)
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("expected %v; got %v", want, got)
+		}
+	}
+}
+
 func ExampleDFS() {
 	g := datastructs.CreateGraph(13)
 	g.AddEdge(0, 1)
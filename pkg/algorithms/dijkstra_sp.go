@@ -0,0 +1,65 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// DijkstraSP solves the single-source shortest-paths problem in edge-weighted
+// digraphs with non-negative edge weights, using Dijkstra's algorithm.
+type DijkstraSP struct {
+	spt
+	pq *datastructs.IndexMinPQ[float64] // priority queue of vertices
+}
+
+// NewDijkstraSP computes a shortest-paths tree from source vertex s to every
+// other vertex in g. It takes O(E log V) time. It panics if any edge has a
+// negative weight.
+func NewDijkstraSP(g datastructs.EdgeWeightedDigraph, s int) *DijkstraSP {
+	for _, e := range g.Edges() {
+		if e.Weight < 0 {
+			panic(fmt.Sprintf("edge %v has negative weight", e))
+		}
+	}
+	validateVertex(s, g.V)
+
+	sp := &DijkstraSP{spt: newSPT(g.V, s), pq: datastructs.NewIndexMinPQ[float64](g.V)}
+	sp.pq.Insert(s, sp.distTo[s])
+	for !sp.pq.IsEmpty() {
+		v := sp.pq.DelMin()
+		for i := range g.Adj[v] {
+			sp.relax(&g.Adj[v][i])
+		}
+	}
+	return sp
+}
+
+// relax relaxes edge e and updates the priority queue if it changed.
+func (sp *DijkstraSP) relax(e *datastructs.DirectedEdge) {
+	v, w := e.From, e.To
+	if sp.distTo[w] > sp.distTo[v]+e.Weight {
+		sp.distTo[w] = sp.distTo[v] + e.Weight
+		sp.edgeTo[w] = e
+		if sp.pq.Contains(w) {
+			sp.pq.DecreaseKey(w, sp.distTo[w])
+		} else {
+			sp.pq.Insert(w, sp.distTo[w])
+		}
+	}
+}
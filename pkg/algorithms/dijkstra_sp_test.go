@@ -0,0 +1,189 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// edgeWeightedDigraph returns a digraph with v vertices and the given edges.
+func edgeWeightedDigraph(v int, edges []datastructs.DirectedEdge) datastructs.EdgeWeightedDigraph {
+	g := datastructs.CreateEdgeWeightedDigraph(v)
+	for _, e := range edges {
+		g.AddEdge(e)
+	}
+	return g
+}
+
+// tinyEWD returns the edge-weighted digraph from tinyEWD.txt in algs4.
+func tinyEWD() datastructs.EdgeWeightedDigraph {
+	return edgeWeightedDigraph(8, []datastructs.DirectedEdge{
+		{From: 4, To: 5, Weight: 0.35},
+		{From: 5, To: 4, Weight: 0.35},
+		{From: 4, To: 7, Weight: 0.37},
+		{From: 5, To: 7, Weight: 0.28},
+		{From: 7, To: 5, Weight: 0.28},
+		{From: 5, To: 1, Weight: 0.32},
+		{From: 0, To: 4, Weight: 0.38},
+		{From: 0, To: 2, Weight: 0.26},
+		{From: 7, To: 3, Weight: 0.39},
+		{From: 1, To: 3, Weight: 0.29},
+		{From: 2, To: 7, Weight: 0.34},
+		{From: 6, To: 2, Weight: 0.40},
+		{From: 3, To: 6, Weight: 0.52},
+		{From: 6, To: 0, Weight: 0.58},
+		{From: 6, To: 4, Weight: 0.93},
+	})
+}
+
+// checkPath verifies that the path returned by sp for v starts at s, ends at
+// v, is connected, and has total weight DistTo(v).
+func checkPath(t *testing.T, sp ShortestPaths, s int, v int) {
+	t.Helper()
+	path := sp.PathTo(v)
+	if v == s {
+		if len(path) != 0 {
+			t.Errorf("expected empty path to source; got %v", path)
+		}
+		return
+	}
+	if len(path) == 0 {
+		t.Errorf("expected a path from %v to %v; got none", s, v)
+		return
+	}
+	if path[0].From != s {
+		t.Errorf("expected path to start at %v; got %v", s, path[0].From)
+	}
+	if path[len(path)-1].To != v {
+		t.Errorf("expected path to end at %v; got %v", v, path[len(path)-1].To)
+	}
+	sum := 0.0
+	for i, e := range path {
+		if i > 0 && path[i-1].To != e.From {
+			t.Errorf("path %v is not connected", path)
+		}
+		sum = sum + e.Weight
+	}
+	if math.Abs(sum-sp.DistTo(v)) > 1e-9 {
+		t.Errorf("expected path weight %v; got %v", sp.DistTo(v), sum)
+	}
+}
+
+func TestDijkstraSP(t *testing.T) {
+	sp := NewDijkstraSP(tinyEWD(), 0)
+	testCases := []struct {
+		name string
+		v    int
+		dist float64
+		len  int
+	}{
+		{"0", 0, 0.00, 0},
+		{"1", 1, 1.05, 3},
+		{"2", 2, 0.26, 1},
+		{"3", 3, 0.99, 3},
+		{"4", 4, 0.38, 1},
+		{"5", 5, 0.73, 2},
+		{"6", 6, 1.51, 4},
+		{"7", 7, 0.60, 2},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !sp.HasPathTo(tc.v) {
+				t.Errorf("expected %v; got %v", true, sp.HasPathTo(tc.v))
+			}
+			if math.Abs(sp.DistTo(tc.v)-tc.dist) > 1e-9 {
+				t.Errorf("expected %v; got %v", tc.dist, sp.DistTo(tc.v))
+			}
+			if len(sp.PathTo(tc.v)) != tc.len {
+				t.Errorf("expected %v; got %v", tc.len, len(sp.PathTo(tc.v)))
+			}
+			checkPath(t, sp, 0, tc.v)
+		})
+	}
+}
+
+func TestDijkstraSPUnreachable(t *testing.T) {
+	g := edgeWeightedDigraph(3, []datastructs.DirectedEdge{{From: 0, To: 1, Weight: 1}})
+	sp := NewDijkstraSP(g, 0)
+	if sp.HasPathTo(2) {
+		t.Errorf("expected %v; got %v", false, sp.HasPathTo(2))
+	}
+	if !math.IsInf(sp.DistTo(2), 1) {
+		t.Errorf("expected %v; got %v", math.Inf(1), sp.DistTo(2))
+	}
+	if sp.PathTo(2) != nil {
+		t.Errorf("expected %v; got %v", nil, sp.PathTo(2))
+	}
+}
+
+func TestDijkstraSPNegativeWeight(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected NewDijkstraSP to panic on a negative edge weight")
+		}
+	}()
+	g := edgeWeightedDigraph(2, []datastructs.DirectedEdge{{From: 0, To: 1, Weight: -1}})
+	NewDijkstraSP(g, 0)
+}
+
+// randomEdgeWeightedDigraph returns a digraph with v vertices and e edges with
+// weights between lo and hi.
+func randomEdgeWeightedDigraph(r *rand.Rand, v int, e int, lo float64, hi float64) datastructs.EdgeWeightedDigraph {
+	g := datastructs.CreateEdgeWeightedDigraph(v)
+	for i := 0; i < e; i++ {
+		g.AddEdge(datastructs.DirectedEdge{From: r.Intn(v), To: r.Intn(v), Weight: lo + (hi-lo)*r.Float64()})
+	}
+	return g
+}
+
+func TestDijkstraSPMatchesBellmanFord(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		v := 1 + r.Intn(30)
+		g := randomEdgeWeightedDigraph(r, v, 3*v, 0, 1)
+		s := r.Intn(v)
+		d := NewDijkstraSP(g, s)
+		b := NewBellmanFordSP(g, s)
+		for w := 0; w < v; w++ {
+			if d.HasPathTo(w) != b.HasPathTo(w) {
+				t.Errorf("expected %v; got %v", b.HasPathTo(w), d.HasPathTo(w))
+				continue
+			}
+			if d.HasPathTo(w) && math.Abs(d.DistTo(w)-b.DistTo(w)) > 1e-9 {
+				t.Errorf("expected %v; got %v", b.DistTo(w), d.DistTo(w))
+			}
+		}
+	}
+}
+
+func ExampleDijkstraSP() {
+	sp := NewDijkstraSP(tinyEWD(), 0)
+	fmt.Printf("%.2f\n", sp.DistTo(6))
+	for _, e := range sp.PathTo(6) {
+		fmt.Println(e)
+	}
+	// Output:
+	// 1.51
+	// 0->2 0.26000
+	// 2->7 0.34000
+	// 7->3 0.39000
+	// 3->6 0.52000
+}
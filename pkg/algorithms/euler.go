@@ -0,0 +1,236 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+var (
+	// ErrOddDegree reports that an undirected graph has vertices of odd degree:
+	// none for an Eulerian cycle, or more than two for an Eulerian path.
+	ErrOddDegree = errors.New("odd degree")
+	// ErrDegreeImbalance reports that a digraph has vertices whose indegree and
+	// outdegree differ: none for an Eulerian cycle, or more than two for an
+	// Eulerian path.
+	ErrDegreeImbalance = errors.New("indegree and outdegree differ")
+	// ErrDisconnectedEdges reports that the edges of a graph aren't all in the
+	// same connected component, so no single walk can use all of them.
+	ErrDisconnectedEdges = errors.New("edges are not connected")
+)
+
+// EulerianCycle finds an Eulerian cycle in the undirected graph g: a closed
+// walk that uses every edge exactly once. The first and last vertices are the
+// same. It uses Hierholzer's algorithm with an explicit stack, so it takes
+// O(E + V) time and doesn't recurse. If there's no Eulerian cycle, it returns
+// an error that wraps ErrOddDegree or ErrDisconnectedEdges. If g has no edges,
+// the cycle is just vertex 0. g must not have self loops.
+func EulerianCycle(g datastructs.Undirected) ([]int, error) {
+	if g.NumVertices() == 0 {
+		return []int{}, nil
+	}
+	for v := 0; v < g.NumVertices(); v++ {
+		if d := len(g.Adjacent(v)); d%2 != 0 {
+			return nil, fmt.Errorf("no Eulerian cycle: %w: vertex %v has degree %v", ErrOddDegree, v, d)
+		}
+	}
+	cycle := hierholzer(g, nonIsolatedVertex(g))
+	if len(cycle) != numEdges(g)/2+1 {
+		return nil, fmt.Errorf("no Eulerian cycle: %w", ErrDisconnectedEdges)
+	}
+	return cycle, nil
+}
+
+// EulerianPath finds an Eulerian path in the undirected graph g: a walk that
+// uses every edge exactly once. If g has two vertices of odd degree, the path
+// starts at the lower-numbered one and ends at the other; otherwise it's a
+// cycle. If there's no Eulerian path, it returns an error that wraps
+// ErrOddDegree or ErrDisconnectedEdges. If g has no edges, the path is just
+// vertex 0. g must not have self loops.
+func EulerianPath(g datastructs.Undirected) ([]int, error) {
+	if g.NumVertices() == 0 {
+		return []int{}, nil
+	}
+	var odd []int
+	for v := 0; v < g.NumVertices(); v++ {
+		if len(g.Adjacent(v))%2 != 0 {
+			odd = append(odd, v)
+		}
+	}
+	if len(odd) > 2 {
+		return nil, fmt.Errorf("no Eulerian path: %w: %v vertices have odd degree, including %v", ErrOddDegree, len(odd), odd[:3])
+	}
+	s := nonIsolatedVertex(g)
+	if len(odd) > 0 {
+		s = odd[0]
+	}
+	path := hierholzer(g, s)
+	if len(path) != numEdges(g)/2+1 {
+		return nil, fmt.Errorf("no Eulerian path: %w", ErrDisconnectedEdges)
+	}
+	return path, nil
+}
+
+// DirectedEulerianCycle finds an Eulerian cycle in the digraph g: a closed
+// directed walk that uses every edge exactly once. The first and last vertices
+// are the same. If there's no Eulerian cycle, it returns an error that wraps
+// ErrDegreeImbalance or ErrDisconnectedEdges. If g has no edges, the cycle is
+// just vertex 0.
+func DirectedEulerianCycle(g datastructs.Adjacency) ([]int, error) {
+	if g.NumVertices() == 0 {
+		return []int{}, nil
+	}
+	indegree := indegrees(g)
+	for v := 0; v < g.NumVertices(); v++ {
+		if outdegree := len(g.Adjacent(v)); outdegree != indegree[v] {
+			return nil, fmt.Errorf("no Eulerian cycle: %w: vertex %v has indegree %v and outdegree %v",
+				ErrDegreeImbalance, v, indegree[v], outdegree)
+		}
+	}
+	cycle := directedHierholzer(g, nonIsolatedVertex(g))
+	if len(cycle) != numEdges(g)+1 {
+		return nil, fmt.Errorf("no Eulerian cycle: %w", ErrDisconnectedEdges)
+	}
+	return cycle, nil
+}
+
+// DirectedEulerianPath finds an Eulerian path in the digraph g: a directed walk
+// that uses every edge exactly once. If some vertex has one more outgoing edge
+// than incoming edges, the path starts there and ends at the vertex with one
+// more incoming edge; otherwise it's a cycle. If there's no Eulerian path, it
+// returns an error that wraps ErrDegreeImbalance or ErrDisconnectedEdges. If g
+// has no edges, the path is just vertex 0.
+func DirectedEulerianPath(g datastructs.Adjacency) ([]int, error) {
+	if g.NumVertices() == 0 {
+		return []int{}, nil
+	}
+	s := nonIsolatedVertex(g)
+	indegree := indegrees(g)
+	var unbalanced []int
+	starts, ends := 0, 0
+	for v := 0; v < g.NumVertices(); v++ {
+		switch d := len(g.Adjacent(v)) - indegree[v]; {
+		case d == 0:
+			continue
+		case d == 1:
+			s = v
+			starts = starts + 1
+		case d == -1:
+			ends = ends + 1
+		}
+		unbalanced = append(unbalanced, v)
+	}
+	if starts > 1 || ends > 1 || len(unbalanced) > starts+ends {
+		return nil, fmt.Errorf("no Eulerian path: %w: vertices %v are unbalanced", ErrDegreeImbalance, unbalanced)
+	}
+	path := directedHierholzer(g, s)
+	if len(path) != numEdges(g)+1 {
+		return nil, fmt.Errorf("no Eulerian path: %w", ErrDisconnectedEdges)
+	}
+	return path, nil
+}
+
+// nonIsolatedVertex returns the lowest-numbered vertex with at least one edge,
+// or 0 if there are no edges.
+func nonIsolatedVertex(g datastructs.Adjacency) int {
+	for v := 0; v < g.NumVertices(); v++ {
+		if len(g.Adjacent(v)) > 0 {
+			return v
+		}
+	}
+	return 0
+}
+
+// hierholzer returns the walk found by Hierholzer's algorithm from s in the
+// undirected graph g. It follows unused edges until it gets stuck, which can
+// only happen back at the start of the current sub-walk, then backtracks and
+// splices in sub-walks from earlier vertices. The walk uses every edge exactly
+// once only if the edges are connected.
+func hierholzer(g datastructs.Undirected, s int) []int {
+	// number the edges so each one can be marked as used from either endpoint
+	type edge struct{ v, w int }
+	var edges []edge
+	incident := make([][]int, g.NumVertices()) // incident[v] = ids of the edges incident to v
+	for v := 0; v < g.NumVertices(); v++ {
+		for _, w := range g.Adjacent(v) {
+			if v < w {
+				incident[v] = append(incident[v], len(edges))
+				incident[w] = append(incident[w], len(edges))
+				edges = append(edges, edge{v, w})
+			}
+		}
+	}
+	used := make([]bool, len(edges))
+	next := make([]int, g.NumVertices()) // next[v] = index in incident[v] of the next edge to try
+
+	// vertices come off the stack in reverse order, so collect them on a second stack
+	walk := datastructs.Stack[int]{}
+	stack := datastructs.Stack[int]{}
+	stack.Push(s)
+	for !stack.IsEmpty() {
+		v := stack.Peek()
+		for next[v] < len(incident[v]) && used[incident[v][next[v]]] {
+			next[v] = next[v] + 1
+		}
+		if next[v] == len(incident[v]) {
+			walk.Push(stack.Pop())
+			continue
+		}
+		e := incident[v][next[v]]
+		used[e] = true
+		if edges[e].v == v {
+			stack.Push(edges[e].w)
+		} else {
+			stack.Push(edges[e].v)
+		}
+	}
+	return popAll(&walk)
+}
+
+// directedHierholzer returns the walk found by Hierholzer's algorithm from s in
+// the digraph g. See hierholzer.
+func directedHierholzer(g datastructs.Adjacency, s int) []int {
+	next := make([]int, g.NumVertices()) // next[v] = index in g.Adjacent(v) of the next edge to use
+
+	// vertices come off the stack in reverse order, so collect them on a second stack
+	walk := datastructs.Stack[int]{}
+	stack := datastructs.Stack[int]{}
+	stack.Push(s)
+	for !stack.IsEmpty() {
+		v := stack.Peek()
+		adj := g.Adjacent(v)
+		if next[v] == len(adj) {
+			walk.Push(stack.Pop())
+			continue
+		}
+		w := adj[next[v]]
+		next[v] = next[v] + 1
+		stack.Push(w)
+	}
+	return popAll(&walk)
+}
+
+// popAll empties the stack s and returns its items in the order they're popped.
+func popAll(s *datastructs.Stack[int]) []int {
+	items := make([]int, 0, s.Size())
+	for !s.IsEmpty() {
+		items = append(items, s.Pop())
+	}
+	return items
+}
@@ -0,0 +1,305 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkEulerian verifies that walk uses each of the E edges in adj exactly once.
+// Undirected edges are counted in both directions.
+func checkEulerian(t *testing.T, adj [][]int, e int, directed bool, walk []int) {
+	t.Helper()
+	if len(walk) != e+1 {
+		t.Errorf("expected a walk of %v vertices; got %v", e+1, walk)
+		return
+	}
+	used := map[[2]int]bool{}
+	for i := 0; i < len(walk)-1; i++ {
+		v, w := walk[i], walk[i+1]
+		if !hasEdge(adj, v, w) {
+			t.Errorf("walk %v uses missing edge %v-%v", walk, v, w)
+		}
+		if !directed && w < v {
+			v, w = w, v
+		}
+		if used[[2]int{v, w}] {
+			t.Errorf("walk %v uses edge %v-%v twice", walk, v, w)
+		}
+		used[[2]int{v, w}] = true
+	}
+}
+
+func undirectedGraph(v int, edges [][2]int) datastructs.Graph {
+	g := datastructs.CreateGraph(v)
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func directedGraph(v int, edges [][2]int) datastructs.Digraph {
+	g := datastructs.CreateDigraph(v)
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func TestEulerianCycle(t *testing.T) {
+	testCases := []struct {
+		name  string
+		v     int
+		edges [][2]int
+		err   error
+	}{
+		{"t1", 3, [][2]int{{0, 1}, {1, 2}, {2, 0}}, nil},
+		// two triangles sharing vertex 0, plus an isolated vertex
+		{"t2", 6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 4}, {4, 0}}, nil},
+		{"t3", 3, [][2]int{{0, 1}, {1, 2}}, ErrOddDegree},
+		// two disjoint triangles
+		{"t4", 6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}, {4, 5}, {5, 3}}, ErrDisconnectedEdges},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := undirectedGraph(tc.v, tc.edges)
+			cycle, err := EulerianCycle(g)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v; got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if cycle[0] != cycle[len(cycle)-1] {
+				t.Errorf("expected %v to start and end with the same vertex", cycle)
+			}
+			checkEulerian(t, g.Adj, g.E, false, cycle)
+		})
+	}
+}
+
+func TestEulerianPath(t *testing.T) {
+	testCases := []struct {
+		name  string
+		v     int
+		edges [][2]int
+		start int
+		err   error
+	}{
+		{"t1", 3, [][2]int{{0, 1}, {1, 2}}, 0, nil},
+		// a triangle with a tail hanging off vertex 2
+		{"t2", 4, [][2]int{{0, 1}, {1, 2}, {2, 0}, {2, 3}}, 2, nil},
+		{"t3", 3, [][2]int{{0, 1}, {1, 2}, {2, 0}}, 0, nil},
+		// a star with three leaves has four odd vertices
+		{"t4", 4, [][2]int{{0, 1}, {0, 2}, {0, 3}}, 0, ErrOddDegree},
+		{"t5", 5, [][2]int{{0, 1}, {2, 3}, {3, 4}, {4, 2}}, 0, ErrDisconnectedEdges},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := undirectedGraph(tc.v, tc.edges)
+			path, err := EulerianPath(g)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v; got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if path[0] != tc.start {
+				t.Errorf("expected %v; got %v", tc.start, path[0])
+			}
+			checkEulerian(t, g.Adj, g.E, false, path)
+		})
+	}
+}
+
+func TestDirectedEulerianCycle(t *testing.T) {
+	testCases := []struct {
+		name  string
+		v     int
+		edges [][2]int
+		err   error
+	}{
+		{"t1", 3, [][2]int{{0, 1}, {1, 2}, {2, 0}}, nil},
+		{"t2", 5, [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 0}, {4, 4}, {2, 4}, {4, 2}}, nil},
+		{"t3", 3, [][2]int{{0, 1}, {1, 2}, {0, 2}}, ErrDegreeImbalance},
+		{"t4", 4, [][2]int{{0, 1}, {1, 0}, {2, 3}, {3, 2}}, ErrDisconnectedEdges},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := directedGraph(tc.v, tc.edges)
+			cycle, err := DirectedEulerianCycle(g)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v; got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if cycle[0] != cycle[len(cycle)-1] {
+				t.Errorf("expected %v to start and end with the same vertex", cycle)
+			}
+			checkEulerian(t, g.Adj, g.E, true, cycle)
+		})
+	}
+}
+
+func TestDirectedEulerianPath(t *testing.T) {
+	testCases := []struct {
+		name  string
+		v     int
+		edges [][2]int
+		start int
+		err   error
+	}{
+		{"t1", 3, [][2]int{{2, 1}, {1, 0}}, 2, nil},
+		{"t2", 4, [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 0}}, 3, nil},
+		{"t3", 3, [][2]int{{0, 1}, {1, 2}, {2, 0}}, 0, nil},
+		// two sources
+		{"t4", 3, [][2]int{{0, 2}, {1, 2}}, 0, ErrDegreeImbalance},
+		// a vertex with two more outgoing edges than incoming ones
+		{"t5", 3, [][2]int{{0, 1}, {0, 2}}, 0, ErrDegreeImbalance},
+		{"t6", 5, [][2]int{{0, 1}, {2, 3}, {3, 4}, {4, 2}}, 0, ErrDisconnectedEdges},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := directedGraph(tc.v, tc.edges)
+			path, err := DirectedEulerianPath(g)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("expected %v; got %v", tc.err, err)
+			}
+			if err != nil {
+				return
+			}
+			if path[0] != tc.start {
+				t.Errorf("expected %v; got %v", tc.start, path[0])
+			}
+			checkEulerian(t, g.Adj, g.E, true, path)
+		})
+	}
+}
+
+func TestEulerianNoEdges(t *testing.T) {
+	g := datastructs.CreateGraph(3)
+	cycle, err := EulerianCycle(g)
+	if err != nil || len(cycle) != 1 || cycle[0] != 0 {
+		t.Errorf("expected %v and %v; got %v and %v", []int{0}, nil, cycle, err)
+	}
+	d := datastructs.CreateDigraph(0)
+	path, err := DirectedEulerianPath(d)
+	if err != nil || len(path) != 0 {
+		t.Errorf("expected %v and %v; got %v and %v", []int{}, nil, path, err)
+	}
+}
+
+func TestEulerianMapGraph(t *testing.T) {
+	// two triangles sharing vertex 0, and the same edges directed
+	edges := [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 4}, {4, 0}}
+	g := undirectedGraph(5, edges)
+	d := directedGraph(5, edges)
+	m := undirectedMapGraph{toMapGraph(g)}
+	dm := toMapGraph(d)
+	testCases := []struct {
+		name string
+		run  func(mapped bool) ([]int, error)
+	}{
+		{"cycle", func(mapped bool) ([]int, error) {
+			if mapped {
+				return EulerianCycle(m)
+			}
+			return EulerianCycle(g)
+		}},
+		{"path", func(mapped bool) ([]int, error) {
+			if mapped {
+				return EulerianPath(m)
+			}
+			return EulerianPath(g)
+		}},
+		{"directed-cycle", func(mapped bool) ([]int, error) {
+			if mapped {
+				return DirectedEulerianCycle(dm)
+			}
+			return DirectedEulerianCycle(d)
+		}},
+		{"directed-path", func(mapped bool) ([]int, error) {
+			if mapped {
+				return DirectedEulerianPath(dm)
+			}
+			return DirectedEulerianPath(d)
+		}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			want, wantErr := tc.run(false)
+			got, err := tc.run(true)
+			if err != wantErr || !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v and %v; got %v and %v", want, wantErr, got, err)
+			}
+		})
+	}
+}
+
+func TestEulerianCycleLarge(t *testing.T) {
+	// a long directed cycle would overflow a recursive implementation's stack
+	// long before it ran out of memory
+	n := 1000000
+	g := datastructs.CreateDigraph(n)
+	for v := 0; v < n; v++ {
+		g.AddEdge(v, (v+1)%n)
+	}
+	cycle, err := DirectedEulerianCycle(g)
+	if err != nil {
+		t.Fatalf("expected %v; got %v", nil, err)
+	}
+	if len(cycle) != n+1 {
+		t.Errorf("expected %v; got %v", n+1, len(cycle))
+	}
+}
+
+func ExampleEulerianPath() {
+	// the Königsberg bridges, with the parallel bridges split by extra vertices:
+	// 0 is the island Kneiphof, 1 and 2 are the river banks, 3 is Lomse
+	g := datastructs.CreateGraph(7)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 4)
+	g.AddEdge(4, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 5)
+	g.AddEdge(5, 2)
+	g.AddEdge(0, 3)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	_, err := EulerianPath(g)
+	fmt.Println(err)
+	// Output:
+	// no Eulerian path: odd degree: 4 vertices have odd degree, including [0 1 2]
+}
+
+func ExampleDirectedEulerianCycle() {
+	g := datastructs.CreateDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(0, 3)
+	g.AddEdge(3, 0)
+	cycle, _ := DirectedEulerianCycle(g)
+	fmt.Println(cycle)
+	// Output:
+	// [0 1 2 0 3 0]
+}
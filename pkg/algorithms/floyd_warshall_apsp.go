@@ -0,0 +1,75 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// FloydWarshallAPSP solves the all-pairs shortest-paths problem in
+// edge-weighted digraphs with no negative cycles, using the Floyd-Warshall
+// algorithm. Edge weights can be negative. It suits dense digraphs.
+type FloydWarshallAPSP struct {
+	apsp
+}
+
+// NewFloydWarshallAPSP computes a shortest path between every pair of vertices
+// in g. It takes O(V³) time and O(V²) space. If g has a negative cycle, it
+// returns a *NegativeCycleError holding one.
+func NewFloydWarshallAPSP(g datastructs.EdgeWeightedDigraph) (*FloydWarshallAPSP, error) {
+	a := &FloydWarshallAPSP{apsp: newAPSP(g.V)}
+	// dist[v][w] and edgeTo[v][w] are the distTo and edgeTo of the tree from v
+	dist := make([][]float64, g.V)
+	edgeTo := make([][]*datastructs.DirectedEdge, g.V)
+	for v := 0; v < g.V; v++ {
+		dist[v] = a.trees[v].distTo
+		edgeTo[v] = a.trees[v].edgeTo
+	}
+
+	// start with the single-edge paths, keeping the lightest of any parallel
+	// edges; a self loop only matters if it's negative
+	for v := 0; v < g.V; v++ {
+		for i := range g.Adj[v] {
+			e := &g.Adj[v][i]
+			if e.Weight < dist[v][e.To] {
+				dist[v][e.To] = e.Weight
+				edgeTo[v][e.To] = e
+			}
+		}
+	}
+
+	// after iteration i, dist[v][w] is the length of a shortest v->w path whose
+	// intermediate vertices are all less than or equal to i
+	for i := 0; i < g.V; i++ {
+		for v := 0; v < g.V; v++ {
+			if edgeTo[v][i] == nil && v != i {
+				continue // no v->i path, so nothing to improve
+			}
+			for w := 0; w < g.V; w++ {
+				if dist[v][w] > dist[v][i]+dist[i][w] {
+					dist[v][w] = dist[v][i] + dist[i][w]
+					edgeTo[v][w] = edgeTo[i][w]
+				}
+			}
+			// a negative v->v path means there's a negative cycle in the
+			// parent links of the tree from v
+			if dist[v][v] < 0 {
+				return nil, &NegativeCycleError{Cycle: negativeCycle(edgeTo[v])}
+			}
+		}
+	}
+	return a, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkAPSP verifies that a agrees with Bellman-Ford from every vertex of g,
+// and that every path it returns is connected and has total weight Dist.
+func checkAPSP(t *testing.T, g datastructs.EdgeWeightedDigraph, a AllPairsShortestPaths) {
+	t.Helper()
+	for s := 0; s < g.V; s++ {
+		sp := NewBellmanFordSP(g, s)
+		for v := 0; v < g.V; v++ {
+			if a.HasPath(s, v) != sp.HasPathTo(v) {
+				t.Errorf("%v->%v: expected %v; got %v", s, v, sp.HasPathTo(v), a.HasPath(s, v))
+				continue
+			}
+			if !a.HasPath(s, v) {
+				if !math.IsInf(a.Dist(s, v), 1) || a.Path(s, v) != nil {
+					t.Errorf("%v->%v: expected %v and %v; got %v and %v", s, v, math.Inf(1), nil, a.Dist(s, v), a.Path(s, v))
+				}
+				continue
+			}
+			if math.Abs(a.Dist(s, v)-sp.DistTo(v)) > 1e-9 {
+				t.Errorf("%v->%v: expected %v; got %v", s, v, sp.DistTo(v), a.Dist(s, v))
+			}
+			path := a.Path(s, v)
+			at, sum := s, 0.0
+			for _, e := range path {
+				if e.From != at {
+					t.Errorf("%v->%v: path %v is not connected", s, v, path)
+				}
+				at = e.To
+				sum = sum + e.Weight
+			}
+			if at != v {
+				t.Errorf("%v->%v: expected path to end at %v; got %v", s, v, v, at)
+			}
+			if math.Abs(sum-a.Dist(s, v)) > 1e-9 {
+				t.Errorf("%v->%v: expected path weight %v; got %v", s, v, a.Dist(s, v), sum)
+			}
+		}
+	}
+}
+
+// checkNegativeCycleError verifies that err is a *NegativeCycleError holding a
+// negative cycle of edges in g.
+func checkNegativeCycleError(t *testing.T, err error) {
+	t.Helper()
+	var ce *NegativeCycleError
+	if !errors.As(err, &ce) {
+		t.Fatalf("expected a *NegativeCycleError; got %v", err)
+	}
+	if len(ce.Cycle) == 0 {
+		t.Fatalf("expected a cycle; got %v", ce.Cycle)
+	}
+	sum := 0.0
+	for i, e := range ce.Cycle {
+		if next := ce.Cycle[(i+1)%len(ce.Cycle)]; e.To != next.From {
+			t.Errorf("cycle %v is not connected", ce.Cycle)
+		}
+		sum = sum + e.Weight
+	}
+	if sum >= 0 {
+		t.Errorf("expected a negative cycle; got weight %v", sum)
+	}
+}
+
+func TestFloydWarshallAPSP(t *testing.T) {
+	testCases := []struct {
+		name string
+		g    datastructs.EdgeWeightedDigraph
+	}{
+		{"tinyEWD", tinyEWD()},
+		{"tinyEWDn", tinyEWDn()},
+		{"tinyEWDAG", tinyEWDAG()},
+		{"parallel", edgeWeightedDigraph(3, []datastructs.DirectedEdge{
+			{From: 0, To: 1, Weight: 2},
+			{From: 0, To: 1, Weight: 1},
+			{From: 1, To: 1, Weight: 0.5},
+			{From: 1, To: 0, Weight: -0.5},
+		})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := NewFloydWarshallAPSP(tc.g)
+			if err != nil {
+				t.Fatalf("expected %v; got %v", nil, err)
+			}
+			checkAPSP(t, tc.g, a)
+		})
+	}
+}
+
+func TestFloydWarshallAPSPNegativeCycle(t *testing.T) {
+	testCases := []struct {
+		name string
+		g    datastructs.EdgeWeightedDigraph
+	}{
+		{"t1", edgeWeightedDigraph(3, []datastructs.DirectedEdge{
+			{From: 0, To: 1, Weight: 1},
+			{From: 1, To: 2, Weight: -2},
+			{From: 2, To: 1, Weight: 1},
+		})},
+		{"t2", edgeWeightedDigraph(2, []datastructs.DirectedEdge{
+			{From: 0, To: 1, Weight: 1},
+			{From: 1, To: 1, Weight: -0.1},
+		})},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewFloydWarshallAPSP(tc.g)
+			checkNegativeCycleError(t, err)
+		})
+	}
+}
+
+func TestFloydWarshallAPSPRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(20)
+		g := randomEdgeWeightedDigraph(r, v, 2*v, -0.3, 1)
+		a, err := NewFloydWarshallAPSP(g)
+		if err != nil {
+			checkNegativeCycleError(t, err)
+			if !NewBellmanFordSP(g, err.(*NegativeCycleError).Cycle[0].From).HasNegativeCycle() {
+				t.Errorf("expected Bellman-Ford to find a negative cycle too")
+			}
+			continue
+		}
+		checkAPSP(t, g, a)
+	}
+}
+
+func ExampleFloydWarshallAPSP() {
+	a, _ := NewFloydWarshallAPSP(tinyEWDn())
+	fmt.Printf("%.2f\n", a.Dist(3, 1))
+	for _, e := range a.Path(3, 1) {
+		fmt.Println(e)
+	}
+	g := tinyEWD()
+	g.AddEdge(datastructs.DirectedEdge{From: 5, To: 4, Weight: -0.66})
+	_, err := NewFloydWarshallAPSP(g)
+	fmt.Println(err)
+	// Output:
+	// -0.06
+	// 3->6 0.52000
+	// 6->4 -1.25000
+	// 4->5 0.35000
+	// 5->1 0.32000
+	// digraph has a negative cycle: 5->4 -0.66000, 4->5 0.35000
+}
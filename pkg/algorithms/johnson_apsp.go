@@ -0,0 +1,90 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// JohnsonAPSP solves the all-pairs shortest-paths problem in edge-weighted
+// digraphs with no negative cycles, using Johnson's algorithm. Edge weights
+// can be negative. It suits sparse digraphs.
+type JohnsonAPSP struct {
+	apsp
+}
+
+// NewJohnsonAPSP computes a shortest path between every pair of vertices in g.
+// It runs the Bellman-Ford algorithm once, from a new vertex with a 0-weight
+// edge to every other vertex, to find a potential h[v] for each vertex. It
+// then runs Dijkstra's algorithm from every vertex, with each edge v->w
+// reweighted to weight + h[v] - h[w], which is never negative and changes the
+// length of every s->t path by the same amount. It takes O(EV log V) time. If g
+// has a negative cycle, it returns a *NegativeCycleError holding one.
+func NewJohnsonAPSP(g datastructs.EdgeWeightedDigraph) (*JohnsonAPSP, error) {
+	augmented := datastructs.CreateEdgeWeightedDigraph(g.V + 1)
+	for _, e := range g.Edges() {
+		augmented.AddEdge(e)
+	}
+	for v := 0; v < g.V; v++ {
+		augmented.AddEdge(datastructs.DirectedEdge{From: g.V, To: v, Weight: 0})
+	}
+	bf := NewBellmanFordSP(augmented, g.V)
+	if bf.HasNegativeCycle() {
+		// the new vertex has no incoming edges, so it can't be on the cycle
+		return nil, &NegativeCycleError{Cycle: bf.NegativeCycle()}
+	}
+	h := bf.distTo[:g.V]
+
+	a := &JohnsonAPSP{apsp: newAPSP(g.V)}
+	for s := 0; s < g.V; s++ {
+		johnsonDijkstra(g, h, &a.trees[s], s)
+	}
+	return a, nil
+}
+
+// johnsonDijkstra runs Dijkstra's algorithm from s using the weights of g
+// reweighted by the potentials h, and stores the shortest-paths tree in tree.
+// The tree's distances are the sums of the original weights, so they don't
+// pick up rounding errors from the reweighting.
+func johnsonDijkstra(g datastructs.EdgeWeightedDigraph, h []float64, tree *spt, s int) {
+	reduced := make([]float64, g.V) // reduced[v] = reweighted length of the s->v path in tree
+	for v := range reduced {
+		reduced[v] = tree.distTo[v]
+	}
+	pq := datastructs.NewIndexMinPQ[float64](g.V)
+	pq.Insert(s, 0)
+	for !pq.IsEmpty() {
+		v := pq.DelMin()
+		for i := range g.Adj[v] {
+			e := &g.Adj[v][i]
+			w := e.To
+			weight := e.Weight + h[v] - h[w]
+			if weight < 0 {
+				weight = 0 // rounding error; the exact value is never negative
+			}
+			if reduced[w] > reduced[v]+weight {
+				reduced[w] = reduced[v] + weight
+				tree.distTo[w] = tree.distTo[v] + e.Weight
+				tree.edgeTo[w] = e
+				if pq.Contains(w) {
+					pq.DecreaseKey(w, reduced[w])
+				} else {
+					pq.Insert(w, reduced[w])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+func TestJohnsonAPSP(t *testing.T) {
+	testCases := []struct {
+		name string
+		g    datastructs.EdgeWeightedDigraph
+	}{
+		{"tinyEWD", tinyEWD()},
+		{"tinyEWDn", tinyEWDn()},
+		{"tinyEWDAG", tinyEWDAG()},
+		{"empty", datastructs.CreateEdgeWeightedDigraph(0)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := NewJohnsonAPSP(tc.g)
+			if err != nil {
+				t.Fatalf("expected %v; got %v", nil, err)
+			}
+			checkAPSP(t, tc.g, a)
+		})
+	}
+}
+
+func TestJohnsonAPSPNegativeCycle(t *testing.T) {
+	g := tinyEWD()
+	g.AddEdge(datastructs.DirectedEdge{From: 5, To: 4, Weight: -0.66})
+	_, err := NewJohnsonAPSP(g)
+	checkNegativeCycleError(t, err)
+}
+
+func TestJohnsonAPSPMatchesFloydWarshall(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(30)
+		g := randomEdgeWeightedDigraph(r, v, 3*v, -0.2, 1)
+		j, jerr := NewJohnsonAPSP(g)
+		f, ferr := NewFloydWarshallAPSP(g)
+		if (jerr == nil) != (ferr == nil) {
+			t.Errorf("expected %v; got %v", ferr, jerr)
+			continue
+		}
+		if jerr != nil {
+			checkNegativeCycleError(t, jerr)
+			continue
+		}
+		for s := 0; s < v; s++ {
+			for w := 0; w < v; w++ {
+				if j.HasPath(s, w) != f.HasPath(s, w) {
+					t.Errorf("expected %v; got %v", f.HasPath(s, w), j.HasPath(s, w))
+				} else if j.HasPath(s, w) && math.Abs(j.Dist(s, w)-f.Dist(s, w)) > 1e-9 {
+					t.Errorf("expected %v; got %v", f.Dist(s, w), j.Dist(s, w))
+				}
+			}
+		}
+	}
+}
+
+func ExampleJohnsonAPSP() {
+	// a routing table: the first hop and cost from every vertex to vertex 1
+	a, _ := NewJohnsonAPSP(tinyEWDn())
+	for s := 0; s < 8; s++ {
+		if s == 1 {
+			continue
+		}
+		fmt.Printf("%v: via %v, %.2f\n", s, a.Path(s, 1)[0].To, a.Dist(s, 1))
+	}
+	// Output:
+	// 0: via 2, 0.93
+	// 2: via 7, 0.67
+	// 3: via 6, -0.06
+	// 4: via 5, 0.67
+	// 5: via 1, 0.32
+	// 6: via 4, -0.58
+	// 7: via 3, 0.33
+}
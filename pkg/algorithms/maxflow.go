@@ -0,0 +1,199 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"math"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// floatingPointEpsilon is the tolerance used when comparing flows and capacities.
+const floatingPointEpsilon = 1e-11
+
+// MaxFlow represents a maximum s-t flow and a minimum s-t cut in a flow
+// network. The flow on each edge is recorded in the Flow field of the
+// network's edges.
+type MaxFlow struct {
+	value  float64 // value of flow
+	inCut  []bool  // inCut[v] = is v on the s side of the min cut?
+	edgeTo []*datastructs.FlowEdge
+}
+
+func newMaxFlow(g datastructs.FlowNetwork, s int, t int) *MaxFlow {
+	validateVertex(s, g.V)
+	validateVertex(t, g.V)
+	if s == t {
+		panic("source equals sink")
+	}
+	f := &MaxFlow{inCut: make([]bool, g.V), edgeTo: make([]*datastructs.FlowEdge, g.V)}
+	// start from the flow already in the network, which is normally zero
+	f.value = excess(g, t)
+	return f
+}
+
+// NewFordFulkerson computes a maximum flow from s to t in network g, using the
+// Ford-Fulkerson algorithm with shortest augmenting paths (found by
+// breadth-first search). It takes O(E^2 V) time in the worst case. It updates
+// the flow on g's edges in place.
+func NewFordFulkerson(g datastructs.FlowNetwork, s int, t int) *MaxFlow {
+	f := newMaxFlow(g, s, t)
+	for f.hasAugmentingPath(g, s, t) {
+		// compute the bottleneck capacity
+		bottle := math.Inf(1)
+		for v := t; v != s; v = f.edgeTo[v].Other(v) {
+			bottle = math.Min(bottle, f.edgeTo[v].ResidualCapacityTo(v))
+		}
+		// augment the flow
+		for v := t; v != s; v = f.edgeTo[v].Other(v) {
+			f.edgeTo[v].AddResidualFlowTo(v, bottle)
+		}
+		f.value = f.value + bottle
+	}
+	return f
+}
+
+// hasAugmentingPath runs a breadth-first search from s in the residual network.
+// If it finds a path to t, the path can be followed back from t with edgeTo.
+// Either way, inCut marks the vertices reachable from s.
+func (f *MaxFlow) hasAugmentingPath(g datastructs.FlowNetwork, s int, t int) bool {
+	for v := 0; v < g.V; v++ {
+		f.inCut[v] = false
+		f.edgeTo[v] = nil
+	}
+	q := datastructs.Queue[int]{}
+	q.Enqueue(s)
+	f.inCut[s] = true
+	for !q.IsEmpty() && !f.inCut[t] {
+		v := q.Dequeue()
+		for _, e := range g.Adj[v] {
+			w := e.Other(v)
+			// if there's residual capacity from v to w
+			if e.ResidualCapacityTo(w) > floatingPointEpsilon && !f.inCut[w] {
+				f.edgeTo[w] = e
+				f.inCut[w] = true
+				q.Enqueue(w)
+			}
+		}
+	}
+	return f.inCut[t]
+}
+
+// dinic holds the state of Dinic's algorithm while it runs.
+type dinic struct {
+	g     datastructs.FlowNetwork
+	level []int // level[v] = distance from s in the residual network, or -1
+	next  []int // next[v] = index in g.Adj[v] of the next edge to try
+}
+
+// NewDinic computes a maximum flow from s to t in network g, using Dinic's
+// algorithm. It repeatedly builds a level graph of shortest residual paths
+// with breadth-first search and saturates it with a blocking flow found by
+// depth-first search. It takes O(V^2 E) time in the worst case and is usually
+// much faster than NewFordFulkerson on large networks. It updates the flow on
+// g's edges in place.
+func NewDinic(g datastructs.FlowNetwork, s int, t int) *MaxFlow {
+	f := newMaxFlow(g, s, t)
+	d := &dinic{g: g, level: make([]int, g.V), next: make([]int, g.V)}
+	for d.buildLevels(s, t) {
+		for v := 0; v < g.V; v++ {
+			d.next[v] = 0
+		}
+		for {
+			pushed := d.augment(s, t, math.Inf(1))
+			if pushed <= floatingPointEpsilon {
+				break
+			}
+			f.value = f.value + pushed
+		}
+	}
+	// the last search found no path to t, so the reachable vertices form the min cut
+	for v := 0; v < g.V; v++ {
+		f.inCut[v] = d.level[v] >= 0
+	}
+	return f
+}
+
+// buildLevels computes the level of each vertex reachable from s in the
+// residual network and returns true if t is reachable.
+func (d *dinic) buildLevels(s int, t int) bool {
+	for v := range d.level {
+		d.level[v] = -1
+	}
+	q := datastructs.Queue[int]{}
+	d.level[s] = 0
+	q.Enqueue(s)
+	for !q.IsEmpty() {
+		v := q.Dequeue()
+		for _, e := range d.g.Adj[v] {
+			w := e.Other(v)
+			if d.level[w] < 0 && e.ResidualCapacityTo(w) > floatingPointEpsilon {
+				d.level[w] = d.level[v] + 1
+				q.Enqueue(w)
+			}
+		}
+	}
+	return d.level[t] >= 0
+}
+
+// augment pushes up to limit units of flow from v to t along edges that go up
+// one level at a time, and returns the amount pushed. Edges that can't carry
+// more flow are skipped for the rest of the phase.
+func (d *dinic) augment(v int, t int, limit float64) float64 {
+	if v == t {
+		return limit
+	}
+	for ; d.next[v] < len(d.g.Adj[v]); d.next[v]++ {
+		e := d.g.Adj[v][d.next[v]]
+		w := e.Other(v)
+		residual := e.ResidualCapacityTo(w)
+		if d.level[w] != d.level[v]+1 || residual <= floatingPointEpsilon {
+			continue
+		}
+		pushed := d.augment(w, t, math.Min(limit, residual))
+		if pushed > floatingPointEpsilon {
+			e.AddResidualFlowTo(w, pushed)
+			return pushed
+		}
+	}
+	return 0
+}
+
+// excess returns the net flow into vertex v.
+func excess(g datastructs.FlowNetwork, v int) float64 {
+	excess := 0.0
+	for _, e := range g.Adj[v] {
+		if v == e.From {
+			excess = excess - e.Flow
+		} else {
+			excess = excess + e.Flow
+		}
+	}
+	return excess
+}
+
+// Value returns the value of the maximum flow.
+func (f *MaxFlow) Value() float64 {
+	return f.value
+}
+
+// InCut returns true if vertex v is on the s side of the minimum cut. The
+// capacities of the edges from the s side to the t side add up to the value of
+// the maximum flow.
+func (f *MaxFlow) InCut(v int) bool {
+	validateVertex(v, len(f.inCut))
+	return f.inCut[v]
+}
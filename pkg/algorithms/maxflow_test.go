@@ -0,0 +1,162 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+type flowEdge struct {
+	from     int
+	to       int
+	capacity float64
+}
+
+// flowNetwork returns a network with v vertices and the given edges, all with
+// zero flow.
+func flowNetwork(v int, edges []flowEdge) datastructs.FlowNetwork {
+	g := datastructs.CreateFlowNetwork(v)
+	for _, e := range edges {
+		g.AddEdge(datastructs.NewFlowEdge(e.from, e.to, e.capacity))
+	}
+	return g
+}
+
+// tinyFN returns the edges of the flow network from tinyFN.txt in algs4.
+func tinyFN() []flowEdge {
+	return []flowEdge{
+		{0, 1, 2.0},
+		{0, 2, 3.0},
+		{1, 3, 3.0},
+		{1, 4, 1.0},
+		{2, 3, 1.0},
+		{2, 4, 1.0},
+		{3, 5, 2.0},
+		{4, 5, 3.0},
+	}
+}
+
+// checkMaxFlow verifies that the flow on g's edges is feasible, that its value
+// matches f.Value(), and that the cut has the same capacity.
+func checkMaxFlow(t *testing.T, g datastructs.FlowNetwork, f *MaxFlow, s int, tt int) {
+	t.Helper()
+	const eps = 1e-9
+	for _, e := range g.Edges() {
+		if e.Flow < -eps || e.Flow > e.Capacity+eps {
+			t.Errorf("edge %v violates its capacity", e)
+		}
+	}
+	for v := 0; v < g.V; v++ {
+		if v != s && v != tt && math.Abs(excess(g, v)) > eps {
+			t.Errorf("flow is not conserved at vertex %v", v)
+		}
+	}
+	if math.Abs(excess(g, tt)-f.Value()) > eps {
+		t.Errorf("expected %v; got %v", f.Value(), excess(g, tt))
+	}
+	if !f.InCut(s) || f.InCut(tt) {
+		t.Errorf("expected %v on the s side and %v on the t side", s, tt)
+	}
+	cut := 0.0
+	for _, e := range g.Edges() {
+		if f.InCut(e.From) && !f.InCut(e.To) {
+			cut = cut + e.Capacity
+		}
+	}
+	if math.Abs(cut-f.Value()) > eps {
+		t.Errorf("expected cut capacity %v; got %v", f.Value(), cut)
+	}
+}
+
+func TestMaxFlow(t *testing.T) {
+	testCases := []struct {
+		name    string
+		maxflow func(datastructs.FlowNetwork, int, int) *MaxFlow
+	}{
+		{"ford-fulkerson", NewFordFulkerson},
+		{"dinic", NewDinic},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := flowNetwork(6, tinyFN())
+			f := tc.maxflow(g, 0, 5)
+			if f.Value() != 4 {
+				t.Errorf("expected %v; got %v", 4, f.Value())
+			}
+			wantCut := []bool{true, false, true, false, false, false}
+			for v, want := range wantCut {
+				if f.InCut(v) != want {
+					t.Errorf("expected InCut(%v) to be %v; got %v", v, want, f.InCut(v))
+				}
+			}
+			checkMaxFlow(t, g, f, 0, 5)
+
+			// running again on the saturated network finds no more flow
+			again := tc.maxflow(g, 0, 5)
+			if again.Value() != 4 {
+				t.Errorf("expected %v; got %v", 4, again.Value())
+			}
+		})
+	}
+}
+
+func TestMaxFlowRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 2 + r.Intn(20)
+		var edges []flowEdge
+		for j := 0; j < 3*v; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a != b {
+				edges = append(edges, flowEdge{a, b, float64(r.Intn(10))})
+			}
+		}
+		g1, g2 := flowNetwork(v, edges), flowNetwork(v, edges)
+		ff := NewFordFulkerson(g1, 0, v-1)
+		d := NewDinic(g2, 0, v-1)
+		if math.Abs(ff.Value()-d.Value()) > 1e-9 {
+			t.Errorf("expected %v; got %v", ff.Value(), d.Value())
+		}
+		checkMaxFlow(t, g1, ff, 0, v-1)
+		checkMaxFlow(t, g2, d, 0, v-1)
+	}
+}
+
+func ExampleMaxFlow() {
+	g := flowNetwork(6, tinyFN())
+	f := NewFordFulkerson(g, 0, 5)
+	fmt.Println("max flow:", f.Value())
+	for _, e := range g.Edges() {
+		if e.Flow > 0 {
+			fmt.Println(e)
+		}
+	}
+	// Output:
+	// max flow: 4
+	// 0->1 2/2
+	// 0->2 2/3
+	// 1->3 1/3
+	// 1->4 1/1
+	// 2->3 1/1
+	// 2->4 1/1
+	// 3->5 2/2
+	// 4->5 2/3
+}
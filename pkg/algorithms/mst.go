@@ -0,0 +1,174 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"math"
+	"sort"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// MST represents a minimum spanning tree of an edge-weighted graph. If the
+// graph is not connected, it represents a minimum spanning forest: the union of
+// a minimum spanning tree in each connected component.
+type MST struct {
+	edges  []datastructs.Edge // edges in the MST
+	weight float64            // total weight of the MST
+}
+
+// Edges returns the edges in the minimum spanning tree (or forest).
+func (m *MST) Edges() []datastructs.Edge {
+	return m.edges
+}
+
+// Weight returns the sum of the edge weights in the minimum spanning tree (or forest).
+func (m *MST) Weight() float64 {
+	return m.weight
+}
+
+func (m *MST) add(e datastructs.Edge) {
+	m.edges = append(m.edges, e)
+	m.weight = m.weight + e.Weight
+}
+
+func byWeight(a datastructs.Edge, b datastructs.Edge) bool {
+	return a.Weight < b.Weight
+}
+
+// LazyPrimMST computes a minimum spanning tree (or forest) of g using the lazy
+// version of Prim's algorithm. Ineligible edges are left on the priority queue
+// and discarded when they're removed. It takes O(E log E) time.
+func LazyPrimMST(g datastructs.EdgeWeightedGraph) *MST {
+	m := &MST{}
+	marked := make([]bool, g.V)
+	pq := datastructs.NewMinPQ(byWeight)
+
+	visit := func(v int) {
+		marked[v] = true
+		for _, e := range g.Adj[v] {
+			if !marked[e.Other(v)] {
+				pq.Insert(e)
+			}
+		}
+	}
+
+	// run Prim from each vertex to get a minimum spanning forest
+	for s := 0; s < g.V; s++ {
+		if marked[s] {
+			continue
+		}
+		visit(s)
+		for !pq.IsEmpty() {
+			e := pq.DelMin()
+			v := e.Either()
+			w := e.Other(v)
+			// lazy, so skip edges with both endpoints already on the tree
+			if marked[v] && marked[w] {
+				continue
+			}
+			m.add(e)
+			if !marked[v] {
+				visit(v)
+			}
+			if !marked[w] {
+				visit(w)
+			}
+		}
+	}
+	return m
+}
+
+// PrimMST computes a minimum spanning tree (or forest) of g using the eager
+// version of Prim's algorithm. It keeps only the lightest edge connecting each
+// non-tree vertex to the tree on an indexed priority queue, so it takes
+// O(E log V) time.
+func PrimMST(g datastructs.EdgeWeightedGraph) *MST {
+	edgeTo := make([]*datastructs.Edge, g.V) // edgeTo[v] = shortest edge from tree vertex to non-tree vertex
+	distTo := make([]float64, g.V)           // distTo[v] = weight of edgeTo[v]
+	marked := make([]bool, g.V)              // marked[v] = true if v on tree
+	pq := datastructs.NewIndexMinPQ[float64](g.V)
+	for v := 0; v < g.V; v++ {
+		distTo[v] = math.Inf(1)
+	}
+
+	// run Prim from each vertex to get a minimum spanning forest
+	for s := 0; s < g.V; s++ {
+		if marked[s] {
+			continue
+		}
+		distTo[s] = 0
+		pq.Insert(s, distTo[s])
+		for !pq.IsEmpty() {
+			v := pq.DelMin()
+			marked[v] = true
+			for i := range g.Adj[v] {
+				e := &g.Adj[v][i]
+				w := e.Other(v)
+				if marked[w] {
+					continue
+				}
+				if e.Weight < distTo[w] {
+					distTo[w] = e.Weight
+					edgeTo[w] = e
+					if pq.Contains(w) {
+						pq.DecreaseKey(w, distTo[w])
+					} else {
+						pq.Insert(w, distTo[w])
+					}
+				}
+			}
+		}
+	}
+
+	m := &MST{}
+	for v := 0; v < g.V; v++ {
+		// the root of each tree has no edge leading to it
+		if edgeTo[v] != nil {
+			m.add(*edgeTo[v])
+		}
+	}
+	return m
+}
+
+// KruskalMST computes a minimum spanning tree (or forest) of g using Kruskal's
+// algorithm. It considers the edges in ascending order of weight and adds each
+// one that doesn't create a cycle, which it detects with a union-find data
+// structure. It takes O(E log E) time.
+func KruskalMST(g datastructs.EdgeWeightedGraph) *MST {
+	edges := g.Edges()
+	// a stable sort keeps the result deterministic when weights are tied
+	sort.SliceStable(edges, func(i, j int) bool {
+		return byWeight(edges[i], edges[j])
+	})
+
+	m := &MST{}
+	uf := datastructs.NewUnionFind(g.V)
+	for _, e := range edges {
+		if len(m.edges) == g.V-1 {
+			break
+		}
+		v := e.Either()
+		w := e.Other(v)
+		// v-w would create a cycle if v and w are already connected
+		if uf.Connected(v, w) {
+			continue
+		}
+		uf.Union(v, w)
+		m.add(e)
+	}
+	return m
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyEWG returns the edge-weighted graph from tinyEWG.txt in algs4.
+func tinyEWG() datastructs.EdgeWeightedGraph {
+	g := datastructs.CreateEdgeWeightedGraph(8)
+	g.AddEdge(datastructs.Edge{V: 4, W: 5, Weight: 0.35})
+	g.AddEdge(datastructs.Edge{V: 4, W: 7, Weight: 0.37})
+	g.AddEdge(datastructs.Edge{V: 5, W: 7, Weight: 0.28})
+	g.AddEdge(datastructs.Edge{V: 0, W: 7, Weight: 0.16})
+	g.AddEdge(datastructs.Edge{V: 1, W: 5, Weight: 0.32})
+	g.AddEdge(datastructs.Edge{V: 0, W: 4, Weight: 0.38})
+	g.AddEdge(datastructs.Edge{V: 2, W: 3, Weight: 0.17})
+	g.AddEdge(datastructs.Edge{V: 1, W: 7, Weight: 0.19})
+	g.AddEdge(datastructs.Edge{V: 0, W: 2, Weight: 0.26})
+	g.AddEdge(datastructs.Edge{V: 1, W: 2, Weight: 0.36})
+	g.AddEdge(datastructs.Edge{V: 1, W: 3, Weight: 0.29})
+	g.AddEdge(datastructs.Edge{V: 2, W: 7, Weight: 0.34})
+	g.AddEdge(datastructs.Edge{V: 6, W: 2, Weight: 0.40})
+	g.AddEdge(datastructs.Edge{V: 3, W: 6, Weight: 0.52})
+	g.AddEdge(datastructs.Edge{V: 6, W: 0, Weight: 0.58})
+	g.AddEdge(datastructs.Edge{V: 6, W: 4, Weight: 0.93})
+	return g
+}
+
+func containsEdge(edges []datastructs.Edge, e datastructs.Edge) bool {
+	for _, f := range edges {
+		if f == e {
+			return true
+		}
+	}
+	return false
+}
+
+func TestMST(t *testing.T) {
+	g := tinyEWG()
+	want := []datastructs.Edge{
+		{V: 0, W: 7, Weight: 0.16},
+		{V: 2, W: 3, Weight: 0.17},
+		{V: 1, W: 7, Weight: 0.19},
+		{V: 0, W: 2, Weight: 0.26},
+		{V: 5, W: 7, Weight: 0.28},
+		{V: 4, W: 5, Weight: 0.35},
+		{V: 6, W: 2, Weight: 0.40},
+	}
+
+	testCases := []struct {
+		name string
+		mst  func(datastructs.EdgeWeightedGraph) *MST
+	}{
+		{"lazy prim", LazyPrimMST},
+		{"prim", PrimMST},
+		{"kruskal", KruskalMST},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := tc.mst(g)
+			if math.Abs(m.Weight()-1.81) > 1e-12 {
+				t.Errorf("expected %v; got %v", 1.81, m.Weight())
+			}
+			if len(m.Edges()) != len(want) {
+				t.Errorf("expected %v; got %v", len(want), len(m.Edges()))
+			}
+			for _, e := range want {
+				if !containsEdge(m.Edges(), e) {
+					t.Errorf("expected %v in %v", e, m.Edges())
+				}
+			}
+		})
+	}
+}
+
+func TestMSTForest(t *testing.T) {
+	// two components: a triangle and a single edge, plus an isolated vertex
+	g := datastructs.CreateEdgeWeightedGraph(6)
+	g.AddEdge(datastructs.Edge{V: 0, W: 1, Weight: 1.0})
+	g.AddEdge(datastructs.Edge{V: 1, W: 2, Weight: 2.0})
+	g.AddEdge(datastructs.Edge{V: 2, W: 0, Weight: 3.0})
+	g.AddEdge(datastructs.Edge{V: 3, W: 4, Weight: 0.5})
+
+	for _, m := range []*MST{LazyPrimMST(g), PrimMST(g), KruskalMST(g)} {
+		if len(m.Edges()) != 3 {
+			t.Errorf("expected %v; got %v", 3, len(m.Edges()))
+		}
+		if m.Weight() != 3.5 {
+			t.Errorf("expected %v; got %v", 3.5, m.Weight())
+		}
+	}
+}
+
+func TestMSTRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		v := 2 + r.Intn(40)
+		g := datastructs.CreateEdgeWeightedGraph(v)
+		for j := 0; j < 3*v; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a != b {
+				g.AddEdge(datastructs.Edge{V: a, W: b, Weight: r.Float64()})
+			}
+		}
+		lazy, eager, kruskal := LazyPrimMST(g), PrimMST(g), KruskalMST(g)
+		if math.Abs(lazy.Weight()-kruskal.Weight()) > 1e-9 {
+			t.Errorf("expected %v; got %v", kruskal.Weight(), lazy.Weight())
+		}
+		if math.Abs(eager.Weight()-kruskal.Weight()) > 1e-9 {
+			t.Errorf("expected %v; got %v", kruskal.Weight(), eager.Weight())
+		}
+		if len(lazy.Edges()) != len(kruskal.Edges()) || len(eager.Edges()) != len(kruskal.Edges()) {
+			t.Errorf("expected %v edges; got %v and %v", len(kruskal.Edges()), len(lazy.Edges()), len(eager.Edges()))
+		}
+	}
+}
+
+func ExampleKruskalMST() {
+	m := KruskalMST(tinyEWG())
+	for _, e := range m.Edges() {
+		fmt.Println(e)
+	}
+	fmt.Printf("%.2f\n", m.Weight())
+	// Output:
+	// 0-7 0.16000
+	// 2-3 0.17000
+	// 1-7 0.19000
+	// 0-2 0.26000
+	// 5-7 0.28000
+	// 4-5 0.35000
+	// 6-2 0.40000
+	// 1.81
+}
@@ -0,0 +1,84 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"math"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// maxPageRankIterations bounds the number of power iterations PageRank runs.
+// With a damping factor below 1 it converges long before this.
+const maxPageRankIterations = 1000
+
+// PageRank computes the PageRank of every vertex in graph g by power
+// iteration. At each step, a random surfer follows an edge out of its current
+// vertex with probability damping, and otherwise jumps to a vertex chosen
+// uniformly at random; a surfer at a vertex with no outgoing edges always
+// jumps. The scores are the long-run probabilities of finding the surfer at
+// each vertex, so they sum to 1. Iteration stops once the scores change by
+// less than tol in total (L1 norm) in one step, or after 1000 steps.
+//
+// g can be a Digraph, or an undirected Graph, in which case each edge is
+// followed in both directions. It panics if damping isn't between 0 and 1, or
+// if tol isn't positive. A typical damping factor is 0.85.
+func PageRank(g datastructs.Adjacency, damping float64, tol float64) []float64 {
+	if damping < 0 || damping > 1 {
+		panic("damping factor must be between 0 and 1")
+	}
+	if !(tol > 0) {
+		panic("tolerance must be positive")
+	}
+	n := g.NumVertices()
+	rank := make([]float64, n)
+	if n == 0 {
+		return rank
+	}
+	for v := range rank {
+		rank[v] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for i := 0; i < maxPageRankIterations; i++ {
+		// rank held by vertices with no outgoing edges is spread evenly
+		dangling := 0.0
+		for v := 0; v < n; v++ {
+			if len(g.Adjacent(v)) == 0 {
+				dangling = dangling + rank[v]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for v := range next {
+			next[v] = base
+		}
+		for v := 0; v < n; v++ {
+			adj := g.Adjacent(v)
+			for _, w := range adj {
+				next[w] = next[w] + damping*rank[v]/float64(len(adj))
+			}
+		}
+
+		diff := 0.0
+		for v := range rank {
+			diff = diff + math.Abs(next[v]-rank[v])
+		}
+		rank, next = next, rank
+		if diff < tol {
+			break
+		}
+	}
+	return rank
+}
@@ -0,0 +1,150 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkPageRank verifies that rank is a probability distribution that's (to
+// within tol) a fixed point of one PageRank step on g.
+func checkPageRank(t *testing.T, g datastructs.Adjacency, damping float64, rank []float64, tol float64) {
+	t.Helper()
+	n := g.NumVertices()
+	sum := 0.0
+	for _, r := range rank {
+		sum = sum + r
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("expected the ranks to sum to 1; got %v", sum)
+	}
+	dangling := 0.0
+	for v := 0; v < n; v++ {
+		if len(g.Adjacent(v)) == 0 {
+			dangling = dangling + rank[v]
+		}
+	}
+	next := make([]float64, n)
+	for v := range next {
+		next[v] = (1-damping)/float64(n) + damping*dangling/float64(n)
+	}
+	for v := 0; v < n; v++ {
+		for _, w := range g.Adjacent(v) {
+			next[w] = next[w] + damping*rank[v]/float64(len(g.Adjacent(v)))
+		}
+	}
+	diff := 0.0
+	for v := range next {
+		diff = diff + math.Abs(next[v]-rank[v])
+	}
+	if diff > tol {
+		t.Errorf("expected a fixed point to within %v; got a difference of %v", tol, diff)
+	}
+}
+
+func TestPageRank(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	gen := datastructs.NewGraphGenerator(r)
+	d := datastructs.CreateDigraph(200)
+	for i := 0; i < 600; i++ {
+		d.AddEdge(r.Intn(d.V), r.Intn(d.V))
+	}
+	testCases := []struct {
+		name string
+		g    datastructs.Adjacency
+	}{
+		{"digraph", d},
+		{"scale-free", gen.PreferentialAttachment(300, 2)},
+		{"sparse", gen.ErdosRenyi(300, 0.005)},
+		{"no-edges", datastructs.CreateDigraph(10)},
+	}
+	for _, tc := range testCases {
+		for _, damping := range []float64{0, 0.5, 0.85} {
+			t.Run(fmt.Sprintf("%v-%v", tc.name, damping), func(t *testing.T) {
+				rank := PageRank(tc.g, damping, 1e-10)
+				checkPageRank(t, tc.g, damping, rank, 1e-9)
+			})
+		}
+	}
+}
+
+func TestPageRankSymmetric(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	// every vertex of a cycle is alike, so they rank equally
+	for _, v := range PageRank(gen.Cycle(7), 0.85, 1e-12) {
+		if math.Abs(v-1.0/7) > 1e-9 {
+			t.Errorf("expected %v; got %v", 1.0/7, v)
+		}
+	}
+	// the centre of a star outranks the leaves
+	rank := PageRank(gen.Star(7), 0.85, 1e-12)
+	for v := 1; v < 7; v++ {
+		if rank[v] >= rank[0] {
+			t.Errorf("expected leaf %v to rank below the centre; got %v and %v", v, rank[v], rank[0])
+		}
+	}
+}
+
+func TestPageRankEmpty(t *testing.T) {
+	if rank := PageRank(datastructs.CreateDigraph(0), 0.85, 1e-6); len(rank) != 0 {
+		t.Errorf("expected %v; got %v", []float64{}, rank)
+	}
+}
+
+func TestPageRankInvalid(t *testing.T) {
+	testCases := []struct {
+		name    string
+		damping float64
+		tol     float64
+	}{
+		{"t1", -0.1, 1e-6},
+		{"t2", 1.1, 1e-6},
+		{"t3", 0.85, 0},
+		{"t4", 0.85, math.NaN()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected PageRank to panic")
+				}
+			}()
+			PageRank(datastructs.CreateDigraph(2), tc.damping, tc.tol)
+		})
+	}
+}
+
+func ExamplePageRank() {
+	// 0 and 1 link to each other, and 2 and 3 both link to 0
+	g := datastructs.CreateDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(2, 0)
+	g.AddEdge(3, 0)
+	for v, rank := range PageRank(g, 0.85, 1e-9) {
+		fmt.Printf("%v %.3f\n", v, rank)
+	}
+	// Output:
+	// 0 0.480
+	// 1 0.445
+	// 2 0.038
+	// 3 0.038
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// minChunk is the smallest number of frontier vertices ParallelBFS hands to a
+// goroutine; below this, the cost of starting one outweighs the work.
+const minChunk = 64
+
+// ParallelBFS performs a level-synchronous breadth-first search on graph g,
+// starting at vertex s, using up to workers goroutines. If workers is less
+// than 1, it uses runtime.GOMAXPROCS(0). Each level of the search is split
+// among the goroutines, which claim newly discovered vertices with an atomic
+// compare-and-swap, so every vertex is claimed exactly once; the goroutines
+// then wait for each other before starting the next level.
+//
+// It returns dist, where dist[v] is the number of edges on a shortest path
+// from s to v, or -1 if there is no such path, and parent, where parent[v] is
+// the previous vertex on such a path, or -1 if v is s or unreachable. The
+// distances are the same as those found by BFS, but when several vertices in
+// one level could be a vertex's parent, which one is chosen depends on
+// scheduling. g is read concurrently, so its Adjacent method must be safe to
+// call from several goroutines at once; Graph, Digraph and CSRGraph are.
+func ParallelBFS(g datastructs.Adjacency, s int, workers int) (dist []int, parent []int) {
+	n := g.NumVertices()
+	validateVertex(s, n)
+	if workers < 1 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	dist = make([]int, n)
+	parent = make([]int, n)
+	for v := 0; v < n; v++ {
+		dist[v] = -1
+		parent[v] = -1
+	}
+	claimed := make([]int32, n) // claimed[v] = 1 once some goroutine has discovered v
+	claimed[s] = 1
+	dist[s] = 0
+
+	// expand discovers the unclaimed neighbours of the vertices in part, which
+	// are at distance d from s, and returns them appended to next. Only the
+	// goroutine that claims a vertex writes its dist and parent.
+	expand := func(part []int, d int, next []int) []int {
+		for _, v := range part {
+			for _, w := range g.Adjacent(v) {
+				if atomic.LoadInt32(&claimed[w]) == 0 && atomic.CompareAndSwapInt32(&claimed[w], 0, 1) {
+					dist[w] = d + 1
+					parent[w] = v
+					next = append(next, w)
+				}
+			}
+		}
+		return next
+	}
+
+	frontier := []int{s}
+	nexts := make([][]int, workers) // nexts[i] = vertices discovered by goroutine i
+	for d := 0; len(frontier) != 0; d++ {
+		chunk := (len(frontier) + workers - 1) / workers
+		if chunk < minChunk {
+			chunk = minChunk
+		}
+		if chunk >= len(frontier) {
+			// too little work to share, so expand the level on this goroutine
+			frontier = expand(frontier, d, nil)
+			continue
+		}
+		var wg sync.WaitGroup
+		parts := 0
+		for lo := 0; lo < len(frontier); lo = lo + chunk {
+			hi := lo + chunk
+			if hi > len(frontier) {
+				hi = len(frontier)
+			}
+			wg.Add(1)
+			go func(i int, part []int) {
+				defer wg.Done()
+				nexts[i] = expand(part, d, nexts[i][:0])
+			}(parts, frontier[lo:hi])
+			parts = parts + 1
+		}
+		wg.Wait()
+
+		size := 0
+		for i := 0; i < parts; i++ {
+			size = size + len(nexts[i])
+		}
+		next := make([]int, 0, size)
+		for i := 0; i < parts; i++ {
+			next = append(next, nexts[i]...)
+		}
+		frontier = next
+	}
+	return dist, parent
+}
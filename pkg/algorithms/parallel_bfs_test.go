@@ -0,0 +1,118 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkParallelBFS verifies the distances and parents returned by ParallelBFS
+// for g and s against the sequential BFS.
+func checkParallelBFS(t *testing.T, g datastructs.Adjacency, s int, dist []int, parent []int) {
+	t.Helper()
+	paths := NewBreadthFirstPaths(g, s)
+	seen := make([]bool, g.NumVertices())
+	BFS(g, s, func(v int) { seen[v] = true })
+	for v := 0; v < g.NumVertices(); v++ {
+		if seen[v] != (dist[v] != -1) {
+			t.Errorf("vertex %v: expected reachable %v; got distance %v", v, seen[v], dist[v])
+		}
+		if dist[v] != paths.DistTo(v) {
+			t.Errorf("vertex %v: expected %v; got %v", v, paths.DistTo(v), dist[v])
+		}
+		if v == s || dist[v] == -1 {
+			if parent[v] != -1 {
+				t.Errorf("vertex %v: expected %v; got %v", v, -1, parent[v])
+			}
+			continue
+		}
+		// the parent must be one level closer to s, with an edge to v
+		p := parent[v]
+		if p < 0 || dist[p] != dist[v]-1 {
+			t.Errorf("vertex %v at distance %v has parent %v", v, dist[v], p)
+			continue
+		}
+		isEdge := false
+		for _, w := range g.Adjacent(p) {
+			if w == v {
+				isEdge = true
+			}
+		}
+		if !isEdge {
+			t.Errorf("parent %v of vertex %v isn't adjacent to it", p, v)
+		}
+	}
+}
+
+func TestParallelBFSGenerated(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	testCases := []struct {
+		name string
+		g    datastructs.Graph
+	}{
+		{"grid", gen.Grid(60, 80)},
+		{"tree", gen.Tree(5000)},
+		{"sparse", gen.ErdosRenyi(5000, 0.0003)},
+		{"dense", gen.ErdosRenyi(1000, 0.05)},
+		{"scale-free", gen.PreferentialAttachment(5000, 3)},
+		{"star", gen.Star(5000)},
+		{"path", gen.Path(300)},
+	}
+	for _, tc := range testCases {
+		for _, workers := range []int{0, 1, 3, 8} {
+			t.Run(fmt.Sprintf("%v-%v", tc.name, workers), func(t *testing.T) {
+				dist, parent := ParallelBFS(tc.g, 0, workers)
+				checkParallelBFS(t, tc.g, 0, dist, parent)
+			})
+		}
+	}
+}
+
+func TestParallelBFSDigraph(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	g := datastructs.CreateDigraph(3000)
+	for i := 0; i < 12000; i++ {
+		g.AddEdge(r.Intn(g.V), r.Intn(g.V))
+	}
+	for _, s := range []int{0, 1, 2} {
+		dist, parent := ParallelBFS(g, s, 4)
+		checkParallelBFS(t, g, s, dist, parent)
+	}
+}
+
+func TestParallelBFSCSRGraph(t *testing.T) {
+	gen := datastructs.NewGraphGenerator(rand.New(rand.NewSource(1)))
+	g := datastructs.CreateCSRGraph(gen.PreferentialAttachment(5000, 2))
+	dist, parent := ParallelBFS(g, 10, 4)
+	checkParallelBFS(t, g, 10, dist, parent)
+}
+
+func ExampleParallelBFS() {
+	g := datastructs.CreateGraph(6)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 3)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 4)
+	dist, _ := ParallelBFS(g, 0, 4)
+	fmt.Println(dist)
+	// Output:
+	// [0 1 1 2 3 -1]
+}
@@ -0,0 +1,153 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// SCC represents the strongly connected components of a digraph. Two vertices
+// are strongly connected if each is reachable from the other. Components are
+// numbered 0 through Count() – 1.
+type SCC struct {
+	id    []int               // id[v] = id of strong component containing v
+	count int                 // number of strongly-connected components
+	dag   datastructs.Digraph // condensation of the digraph
+}
+
+// NewKosarajuSharirSCC computes the strongly connected components of digraph g
+// using the Kosaraju-Sharir algorithm: it runs a depth-first search on g,
+// considering the unmarked vertices in reverse postorder of the reverse of g.
+// Each search from the outer loop marks exactly one component.
+func NewKosarajuSharirSCC(g datastructs.Adjacency) *SCC {
+	r := reverse(g)
+	order := NewDepthFirstOrder(r).ReversePostOrder()
+
+	c := &SCC{id: make([]int, g.NumVertices())}
+	marked := make([]bool, g.NumVertices())
+	for _, s := range order {
+		if marked[s] {
+			continue
+		}
+		dfs(g, s, marked, func(v int) {
+			c.id[v] = c.count
+		})
+		c.count = c.count + 1
+	}
+	c.condense(g)
+	return c
+}
+
+// tarjan holds the state of Tarjan's algorithm while it runs.
+type tarjan struct {
+	marked []bool                 // marked[v] = has v been visited?
+	low    []int                  // low[v] = low number of v
+	pre    int                    // preorder number counter
+	stack  datastructs.Stack[int] // vertices of components not yet complete
+	scc    *SCC
+}
+
+// NewTarjanSCC computes the strongly connected components of digraph g using
+// Tarjan's algorithm, which finds them all in a single depth-first search by
+// tracking the lowest preorder number reachable from each vertex.
+func NewTarjanSCC(g datastructs.Adjacency) *SCC {
+	t := &tarjan{marked: make([]bool, g.NumVertices()), low: make([]int, g.NumVertices()), scc: &SCC{id: make([]int, g.NumVertices())}}
+	for v := 0; v < g.NumVertices(); v++ {
+		if !t.marked[v] {
+			t.dfs(g, v)
+		}
+	}
+	t.scc.condense(g)
+	return t.scc
+}
+
+func (t *tarjan) dfs(g datastructs.Adjacency, v int) {
+	t.marked[v] = true
+	t.low[v] = t.pre
+	t.pre = t.pre + 1
+	min := t.low[v]
+	t.stack.Push(v)
+	for _, w := range g.Adjacent(v) {
+		if !t.marked[w] {
+			t.dfs(g, w)
+		}
+		if t.low[w] < min {
+			min = t.low[w]
+		}
+	}
+	if min < t.low[v] {
+		t.low[v] = min
+		return
+	}
+	// v is the root of a component; everything above it on the stack is in it
+	for {
+		w := t.stack.Pop()
+		t.scc.id[w] = t.scc.count
+		// make sure w no longer lowers the low number of vertices in other components
+		t.low[w] = g.NumVertices()
+		if w == v {
+			break
+		}
+	}
+	t.scc.count = t.scc.count + 1
+}
+
+// Count returns the number of strongly connected components.
+func (c *SCC) Count() int {
+	return c.count
+}
+
+// ID returns the component id of the strong component containing vertex v.
+func (c *SCC) ID(v int) int {
+	validateVertex(v, len(c.id))
+	return c.id[v]
+}
+
+// StronglyConnected returns true if vertices v and w are in the same strong component.
+func (c *SCC) StronglyConnected(v int, w int) bool {
+	return c.ID(v) == c.ID(w)
+}
+
+// Components returns the vertices in each strong component, indexed by
+// component id. The vertices of each component are in ascending order.
+func (c *SCC) Components() [][]int {
+	components := make([][]int, c.count)
+	for v, id := range c.id {
+		components[id] = append(components[id], v)
+	}
+	return components
+}
+
+// condense builds the condensation of digraph g from the component ids.
+func (c *SCC) condense(g datastructs.Adjacency) {
+	c.dag = datastructs.CreateDigraph(c.count)
+	for v := 0; v < g.NumVertices(); v++ {
+		for _, w := range g.Adjacent(v) {
+			if c.id[v] != c.id[w] {
+				c.dag.AddEdge(c.id[v], c.id[w])
+			}
+		}
+	}
+}
+
+// Condensation returns the component digraph (or kernel DAG) of the digraph:
+// it has one vertex per strong component, numbered by component id, and an
+// edge from one component to another if the digraph has an edge between their
+// vertices. It's always acyclic. It's built along with the components, so
+// later changes to the digraph don't affect it. The caller must not modify it.
+func (c *SCC) Condensation() datastructs.Digraph {
+	return c.dag
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// tinyDG returns the digraph from tinyDG.txt in algs4.
+func tinyDG() datastructs.Digraph {
+	g := datastructs.CreateDigraph(13)
+	edges := [][2]int{
+		{4, 2}, {2, 3}, {3, 2}, {6, 0}, {0, 1}, {2, 0}, {11, 12}, {12, 9}, {9, 10},
+		{9, 11}, {7, 9}, {10, 12}, {11, 4}, {4, 3}, {3, 5}, {6, 8}, {8, 6}, {5, 4},
+		{0, 5}, {6, 4}, {6, 9}, {7, 6},
+	}
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+// reachable returns reach[v][w] = true if w is reachable from v.
+func reachable(g datastructs.Digraph) [][]bool {
+	reach := make([][]bool, g.V)
+	for v := 0; v < g.V; v++ {
+		reach[v] = make([]bool, g.V)
+		DFS(g, v, func(w int) { reach[v][w] = true })
+	}
+	return reach
+}
+
+func TestSCC(t *testing.T) {
+	g := tinyDG()
+	testCases := []struct {
+		name string
+		scc  func(datastructs.Adjacency) *SCC
+	}{
+		{"kosaraju-sharir", NewKosarajuSharirSCC},
+		{"tarjan", NewTarjanSCC},
+	}
+	want := [][]int{{0, 2, 3, 4, 5}, {1}, {6, 8}, {7}, {9, 10, 11, 12}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := tc.scc(g)
+			if c.Count() != 5 {
+				t.Errorf("expected %v; got %v", 5, c.Count())
+			}
+			for _, component := range want {
+				for _, v := range component {
+					if !c.StronglyConnected(component[0], v) {
+						t.Errorf("expected %v and %v to be strongly connected", component[0], v)
+					}
+				}
+			}
+			if c.StronglyConnected(0, 1) || c.StronglyConnected(6, 7) || c.StronglyConnected(9, 0) {
+				t.Errorf("expected components %v; got %v", want, c.Components())
+			}
+		})
+	}
+}
+
+func TestSCCRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(25)
+		g := datastructs.CreateDigraph(v)
+		for j := 0; j < 2*v; j++ {
+			g.AddEdge(r.Intn(v), r.Intn(v))
+		}
+		reach := reachable(g)
+		kosaraju := NewKosarajuSharirSCC(g)
+		tarjan := NewTarjanSCC(g)
+		if kosaraju.Count() != tarjan.Count() {
+			t.Errorf("expected %v; got %v", kosaraju.Count(), tarjan.Count())
+		}
+		for a := 0; a < v; a++ {
+			for b := 0; b < v; b++ {
+				want := reach[a][b] && reach[b][a]
+				if kosaraju.StronglyConnected(a, b) != want {
+					t.Errorf("expected %v; got %v", want, kosaraju.StronglyConnected(a, b))
+				}
+				if tarjan.StronglyConnected(a, b) != want {
+					t.Errorf("expected %v; got %v", want, tarjan.StronglyConnected(a, b))
+				}
+			}
+		}
+		for _, c := range []*SCC{kosaraju, tarjan} {
+			if NewDirectedCycle(c.Condensation()).HasCycle() {
+				t.Errorf("expected the condensation to be acyclic")
+			}
+		}
+	}
+}
+
+func TestSCCCondensation(t *testing.T) {
+	g := tinyDG()
+	c := NewTarjanSCC(g)
+	dag := c.Condensation()
+	if dag.V != c.Count() {
+		t.Errorf("expected %v; got %v", c.Count(), dag.V)
+	}
+	// every edge between components appears once in the condensation
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if c.ID(v) != c.ID(w) && !hasEdge(dag.Adj, c.ID(v), c.ID(w)) {
+				t.Errorf("expected edge %v->%v in the condensation", c.ID(v), c.ID(w))
+			}
+		}
+	}
+	// {0, 2, 3, 4, 5} -> {1}, {6, 8} -> {0, 2, 3, 4, 5}, {6, 8} -> {9, 10, 11, 12},
+	// {9, 10, 11, 12} -> {0, 2, 3, 4, 5}, {7} -> {6, 8} and {7} -> {9, 10, 11, 12}
+	if dag.E != 6 {
+		t.Errorf("expected %v; got %v", 6, dag.E)
+	}
+}
+
+func TestSCCCondensationAfterChange(t *testing.T) {
+	g := tinyDG()
+	c := NewTarjanSCC(g)
+	before := c.Condensation()
+	want := before.String()
+	// 1 -> 0 would merge components, but c describes the digraph as it was
+	g.AddEdge(1, 0)
+	dag := c.Condensation()
+	if dag.String() != want {
+		t.Errorf("expected %v; got %v", want, dag.String())
+	}
+	if NewDirectedCycle(dag).HasCycle() {
+		t.Errorf("expected the condensation to be acyclic")
+	}
+}
+
+func ExampleSCC() {
+	c := NewKosarajuSharirSCC(tinyDG())
+	fmt.Println(c.Count(), "strong components")
+	for _, component := range c.Components() {
+		fmt.Println(component)
+	}
+	// Output:
+	// 5 strong components
+	// [1]
+	// [0 2 3 4 5]
+	// [9 10 11 12]
+	// [6 8]
+	// [7]
+}
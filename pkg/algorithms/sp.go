@@ -0,0 +1,81 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"math"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// ShortestPaths is the API shared by the single-source shortest-path
+// algorithms on edge-weighted digraphs.
+type ShortestPaths interface {
+	// DistTo returns the length of a shortest path from the source to v, or
+	// positive infinity if there is no such path.
+	DistTo(v int) float64
+	// HasPathTo returns true if there is a path from the source to v.
+	HasPathTo(v int) bool
+	// PathTo returns the edges on a shortest path from the source to v, in
+	// order, or nil if there is no such path.
+	PathTo(v int) []datastructs.DirectedEdge
+}
+
+// spt is a shortest-paths tree, stored as parent links. It's shared by the
+// shortest-path algorithms, which differ only in how they build it.
+type spt struct {
+	distTo []float64                   // distTo[v] = distance of shortest s->v path
+	edgeTo []*datastructs.DirectedEdge // edgeTo[v] = last edge on shortest s->v path
+}
+
+func newSPT(v int, s int) spt {
+	t := spt{distTo: make([]float64, v), edgeTo: make([]*datastructs.DirectedEdge, v)}
+	for i := 0; i < v; i++ {
+		t.distTo[i] = math.Inf(1)
+	}
+	t.distTo[s] = 0
+	return t
+}
+
+// DistTo returns the length of a shortest path from the source to v, or
+// positive infinity if there is no such path.
+func (t *spt) DistTo(v int) float64 {
+	validateVertex(v, len(t.distTo))
+	return t.distTo[v]
+}
+
+// HasPathTo returns true if there is a path from the source to v.
+func (t *spt) HasPathTo(v int) bool {
+	validateVertex(v, len(t.distTo))
+	return !math.IsInf(t.distTo[v], 1)
+}
+
+// PathTo returns the edges on a shortest path from the source to v, in order,
+// or nil if there is no such path.
+func (t *spt) PathTo(v int) []datastructs.DirectedEdge {
+	if !t.HasPathTo(v) {
+		return nil
+	}
+	path := datastructs.Stack[datastructs.DirectedEdge]{}
+	for e := t.edgeTo[v]; e != nil; e = t.edgeTo[e.From] {
+		path.Push(*e)
+	}
+	edges := make([]datastructs.DirectedEdge, 0, path.Size())
+	for !path.IsEmpty() {
+		edges = append(edges, path.Pop())
+	}
+	return edges
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// CycleError is the error returned when an operation that requires a directed
+// acyclic graph (DAG) is given a digraph with a directed cycle.
+type CycleError struct {
+	// Cycle holds the vertices on a directed cycle. The first and last vertices
+	// are the same.
+	Cycle []int
+}
+
+func (e *CycleError) Error() string {
+	vertices := make([]string, len(e.Cycle))
+	for i, v := range e.Cycle {
+		vertices[i] = fmt.Sprint(v)
+	}
+	return "digraph has a cycle: " + strings.Join(vertices, " -> ")
+}
+
+// Topological represents a topological order of a DAG: an order of the
+// vertices in which every directed edge points from an earlier vertex to a
+// later one.
+type Topological struct {
+	order []int // topological order
+	rank  []int // rank[v] = rank of vertex v in order
+}
+
+func newTopological(order []int) *Topological {
+	t := &Topological{order: order, rank: make([]int, len(order))}
+	for i, v := range order {
+		t.rank[v] = i
+	}
+	return t
+}
+
+// NewTopological computes a topological order of digraph g as the reverse
+// postorder of a depth-first search. If g has a directed cycle, it returns a
+// *CycleError holding one.
+func NewTopological(g datastructs.Adjacency) (*Topological, error) {
+	c := NewDirectedCycle(g)
+	if c.HasCycle() {
+		return nil, &CycleError{Cycle: c.Cycle()}
+	}
+	return newTopological(NewDepthFirstOrder(g).ReversePostOrder()), nil
+}
+
+// NewKahnTopological computes a topological order of digraph g using Kahn's
+// algorithm, which repeatedly removes a vertex with no incoming edges. When
+// several vertices are available at once, it takes the smallest according to
+// less, so the order is fully determined by g and less. If less is nil, it
+// takes the lowest-numbered vertex, which gives the lexicographically smallest
+// topological order. If g has a directed cycle, it returns a *CycleError
+// holding one.
+func NewKahnTopological(g datastructs.Adjacency, less func(v int, w int) bool) (*Topological, error) {
+	if less == nil {
+		less = func(v int, w int) bool { return v < w }
+	}
+	indegree := indegrees(g)
+
+	// vertices with no remaining incoming edges
+	pq := datastructs.NewMinPQ(less)
+	for v := 0; v < g.NumVertices(); v++ {
+		if indegree[v] == 0 {
+			pq.Insert(v)
+		}
+	}
+
+	order := make([]int, 0, g.NumVertices())
+	for !pq.IsEmpty() {
+		v := pq.DelMin()
+		order = append(order, v)
+		for _, w := range g.Adjacent(v) {
+			indegree[w] = indegree[w] - 1
+			if indegree[w] == 0 {
+				pq.Insert(w)
+			}
+		}
+	}
+
+	// the vertices left over are all on, or reachable from, a cycle
+	if len(order) != g.NumVertices() {
+		return nil, &CycleError{Cycle: NewDirectedCycle(g).Cycle()}
+	}
+	return newTopological(order), nil
+}
+
+// Order returns the vertices in topological order.
+func (t *Topological) Order() []int {
+	return t.order
+}
+
+// Rank returns the position of vertex v in the topological order.
+func (t *Topological) Rank(v int) int {
+	validateVertex(v, len(t.rank))
+	return t.rank[v]
+}
@@ -0,0 +1,140 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// checkTopological verifies that every edge in g points forward in the order.
+func checkTopological(t *testing.T, g datastructs.Digraph, top *Topological) {
+	t.Helper()
+	if len(top.Order()) != g.V {
+		t.Errorf("expected %v vertices; got %v", g.V, len(top.Order()))
+	}
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if top.Rank(v) >= top.Rank(w) {
+				t.Errorf("edge %v->%v points backward in %v", v, w, top.Order())
+			}
+		}
+	}
+}
+
+func TestTopological(t *testing.T) {
+	g := tinyDAG()
+	top, err := NewTopological(g)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int{8, 7, 2, 3, 0, 5, 1, 6, 9, 11, 10, 12, 4}
+	if !reflect.DeepEqual(top.Order(), want) {
+		t.Errorf("expected %v; got %v", want, top.Order())
+	}
+	checkTopological(t, g, top)
+}
+
+func TestKahnTopological(t *testing.T) {
+	g := tinyDAG()
+	top, err := NewKahnTopological(g, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []int{2, 0, 1, 3, 5, 8, 7, 6, 4, 9, 10, 11, 12}
+	if !reflect.DeepEqual(top.Order(), want) {
+		t.Errorf("expected %v; got %v", want, top.Order())
+	}
+	checkTopological(t, g, top)
+
+	// break ties in favour of the highest-numbered vertex instead
+	top, err = NewKahnTopological(g, func(v int, w int) bool { return v > w })
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = []int{8, 7, 2, 3, 0, 6, 9, 11, 12, 10, 5, 4, 1}
+	if !reflect.DeepEqual(top.Order(), want) {
+		t.Errorf("expected %v; got %v", want, top.Order())
+	}
+	checkTopological(t, g, top)
+}
+
+func TestTopologicalCycle(t *testing.T) {
+	g := tinyDAG()
+	g.AddEdge(12, 6) // 6->9->12->6
+	testCases := []struct {
+		name string
+		top  func(datastructs.Adjacency) (*Topological, error)
+	}{
+		{"dfs", NewTopological},
+		{"kahn", func(g datastructs.Adjacency) (*Topological, error) { return NewKahnTopological(g, nil) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			top, err := tc.top(g)
+			if top != nil {
+				t.Errorf("expected %v; got %v", nil, top.Order())
+			}
+			var cycleErr *CycleError
+			if !errors.As(err, &cycleErr) {
+				t.Fatalf("expected a *CycleError; got %v", err)
+			}
+			checkCycle(t, g.Adj, cycleErr.Cycle)
+		})
+	}
+}
+
+func TestTopologicalRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(30)
+		// edges from lower to higher numbers of a random permutation can't form a cycle
+		perm := r.Perm(v)
+		g := datastructs.CreateDigraph(v)
+		for j := 0; j < 2*v; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a < b {
+				g.AddEdge(perm[a], perm[b])
+			}
+		}
+		dfs, err := NewTopological(g)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkTopological(t, g, dfs)
+		kahn, err := NewKahnTopological(g, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		checkTopological(t, g, kahn)
+	}
+}
+
+func ExampleNewKahnTopological() {
+	g := datastructs.CreateDigraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	_, err := NewKahnTopological(g, nil)
+	fmt.Println(err)
+	// Output:
+	// digraph has a cycle: 2 -> 0 -> 1 -> 2
+}
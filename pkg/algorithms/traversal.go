@@ -0,0 +1,132 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"context"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// Decision tells a traversal how to proceed after it visits a vertex.
+type Decision int
+
+const (
+	// Continue carries on with the traversal as usual.
+	Continue Decision = iota
+	// Skip carries on with the traversal, but doesn't explore the neighbours
+	// of the vertex just visited. They may still be reached by another path.
+	Skip
+	// Stop ends the traversal immediately.
+	Stop
+)
+
+// Visitor is a callback for processing vertices during a traversal. It will be
+// invoked once per discovered vertex, and its result decides how the traversal
+// proceeds.
+type Visitor func(int) Decision
+
+// BFSContext performs a breadth-first search on graph g, starting at vertex s,
+// visiting the vertices in the same order as BFS. It invokes visit on each
+// discovered vertex and stops early if visit returns Stop. It checks ctx before
+// visiting s and before exploring each vertex, and returns ctx.Err() if ctx is
+// cancelled or its deadline expires; otherwise it returns nil.
+func BFSContext(ctx context.Context, g datastructs.Adjacency, s int, visit Visitor) error {
+	validateVertex(s, g.NumVertices())
+	marked := make([]bool, g.NumVertices())
+	q := datastructs.Queue[int]{}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	marked[s] = true
+	switch visit(s) {
+	case Stop:
+		return nil
+	case Continue:
+		q.Enqueue(s)
+	}
+
+	for !q.IsEmpty() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		v := q.Dequeue()
+		for _, w := range g.Adjacent(v) {
+			if marked[w] {
+				continue
+			}
+			marked[w] = true
+			switch visit(w) {
+			case Stop:
+				return nil
+			case Continue:
+				q.Enqueue(w)
+			}
+		}
+	}
+	return nil
+}
+
+// DFSContext performs a depth-first search on graph g, starting at vertex s,
+// visiting the vertices in the same order as DFS. It invokes visit on each
+// discovered vertex and stops early if visit returns Stop. It checks ctx before
+// visiting s and before exploring each vertex, and returns ctx.Err() if ctx is
+// cancelled or its deadline expires; otherwise it returns nil. Like
+// DFSIterative, it doesn't recurse.
+func DFSContext(ctx context.Context, g datastructs.Adjacency, s int, visit Visitor) error {
+	validateVertex(s, g.NumVertices())
+	marked := make([]bool, g.NumVertices())
+	next := make([]int, g.NumVertices()) // next[v] = index in g.Adjacent(v) of the next neighbour to consider
+	stack := datastructs.Stack[int]{}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	marked[s] = true
+	switch visit(s) {
+	case Stop:
+		return nil
+	case Continue:
+		stack.Push(s)
+	}
+
+	for !stack.IsEmpty() {
+		v := stack.Peek()
+		if next[v] == 0 {
+			// about to explore v for the first time
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+		}
+		adj := g.Adjacent(v)
+		if next[v] == len(adj) {
+			stack.Pop()
+			continue
+		}
+		w := adj[next[v]]
+		next[v] = next[v] + 1
+		if marked[w] {
+			continue
+		}
+		marked[w] = true
+		switch visit(w) {
+		case Stop:
+			return nil
+		case Continue:
+			stack.Push(w)
+		}
+	}
+	return nil
+}
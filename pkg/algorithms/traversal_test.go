@@ -0,0 +1,196 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package algorithms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/pcoet/golang-patterns/pkg/datastructs"
+)
+
+// traversalGraph returns the graph used by TestBFS.
+func traversalGraph() datastructs.Graph {
+	g := datastructs.CreateGraph(13)
+	g.AddEdge(9, 3)
+	g.AddEdge(9, 11)
+	g.AddEdge(9, 5)
+	g.AddEdge(3, 12)
+	g.AddEdge(3, 0)
+	g.AddEdge(3, 7)
+	g.AddEdge(11, 6)
+	g.AddEdge(5, 4)
+	g.AddEdge(5, 10)
+	g.AddEdge(10, 1)
+	g.AddEdge(10, 2)
+	g.AddEdge(10, 8)
+	return g
+}
+
+type traversal func(context.Context, datastructs.Adjacency, int, Visitor) error
+
+func TestTraversalContextOrder(t *testing.T) {
+	g := traversalGraph()
+	testCases := []struct {
+		name  string
+		plain func(datastructs.Adjacency, int, Proc)
+		ctx   traversal
+	}{
+		{"bfs", BFS, BFSContext},
+		{"dfs", DFS, DFSContext},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var want, got []int
+			tc.plain(g, 9, func(v int) { want = append(want, v) })
+			err := tc.ctx(context.Background(), g, 9, func(v int) Decision {
+				got = append(got, v)
+				return Continue
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("expected %v; got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestTraversalContextStop(t *testing.T) {
+	g := traversalGraph()
+	testCases := []struct {
+		name string
+		ctx  traversal
+		want []int
+	}{
+		{"bfs", BFSContext, []int{9, 3, 11, 5, 12, 0}},
+		{"dfs", DFSContext, []int{9, 3, 12, 0}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []int
+			err := tc.ctx(context.Background(), g, 9, func(v int) Decision {
+				got = append(got, v)
+				if v == 0 {
+					return Stop
+				}
+				return Continue
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v; got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTraversalContextSkip(t *testing.T) {
+	g := traversalGraph()
+	testCases := []struct {
+		name string
+		ctx  traversal
+		want []int
+	}{
+		{"bfs", BFSContext, []int{9, 3, 11, 5, 12, 0, 7, 6}},
+		{"dfs", DFSContext, []int{9, 3, 12, 0, 7, 11, 6, 5}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []int
+			// don't explore past vertex 5, so 4, 10, 1, 2 and 8 are never reached
+			err := tc.ctx(context.Background(), g, 9, func(v int) Decision {
+				got = append(got, v)
+				if v == 5 {
+					return Skip
+				}
+				return Continue
+			})
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expected %v; got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestTraversalContextCancel(t *testing.T) {
+	g := traversalGraph()
+	for _, tr := range []traversal{BFSContext, DFSContext} {
+		ctx, cancel := context.WithCancel(context.Background())
+		count := 0
+		err := tr(ctx, g, 9, func(v int) Decision {
+			count = count + 1
+			if count == 3 {
+				cancel()
+			}
+			return Continue
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected %v; got %v", context.Canceled, err)
+		}
+		if count >= g.V {
+			t.Errorf("expected the traversal to stop early; visited %v vertices", count)
+		}
+		cancel()
+	}
+}
+
+func TestTraversalContextCancelled(t *testing.T) {
+	g := traversalGraph()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	for _, tr := range []traversal{BFSContext, DFSContext} {
+		count := 0
+		err := tr(ctx, g, 9, func(v int) Decision {
+			count = count + 1
+			return Continue
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected %v; got %v", context.Canceled, err)
+		}
+		if count != 0 {
+			t.Errorf("expected %v; got %v", 0, count)
+		}
+	}
+}
+
+func ExampleBFSContext() {
+	g := traversalGraph()
+	// find the first vertex with a degree of 4
+	found := -1
+	err := BFSContext(context.Background(), g, 9, func(v int) Decision {
+		if g.Degree(v) == 4 {
+			found = v
+			return Stop
+		}
+		return Continue
+	})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(found)
+	// Output:
+	// 3
+}
@@ -0,0 +1,42 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+// Adjacency is the read-only view of a graph shared by Graph, Digraph and
+// CSRGraph. It lets traversal algorithms run on any of them, or on any other
+// representation that can list a vertex's neighbours.
+type Adjacency interface {
+	// NumVertices returns the number of vertices, which are named 0 through
+	// NumVertices() – 1.
+	NumVertices() int
+	// Adjacent returns the vertices adjacent to v, in order. In a digraph,
+	// these are the vertices that v points to. The caller must not modify the
+	// returned slice.
+	Adjacent(v int) []int
+}
+
+// Undirected is an Adjacency whose edges have no direction: w is adjacent to
+// v as many times as v is adjacent to w. Algorithms that are only correct on
+// undirected graphs, such as finding connected components, take an Undirected
+// rather than an Adjacency, so that a digraph can't be passed to them by
+// mistake. Graph and CSRGraph implement it. Another representation can
+// implement it by adding an Undirected method, as long as its adjacency lists
+// are symmetric.
+type Undirected interface {
+	Adjacency
+	// Undirected does nothing; it marks the graph as undirected.
+	Undirected()
+}
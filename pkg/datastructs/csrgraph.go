@@ -0,0 +1,165 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// CSRGraph represents an immutable simple undirected graph of vertices named 0
+// through V – 1, stored in compressed sparse row form: the adjacency lists of
+// all of the vertices are concatenated into one slice of 32-bit vertex names,
+// and a second slice holds the offset of each list. Each list entry takes half
+// the memory it does in a Graph, each vertex takes one offset instead of a
+// slice header, and each list is next to the following one in memory. It has
+// the same adjacency lists, in the same order, as the Graph it was built from.
+// It can hold at most math.MaxInt32 vertices.
+type CSRGraph struct {
+	e       int   // number of edges
+	offsets []int // the vertices adjacent to v are targets[offsets[v]:offsets[v+1]]
+	targets []int32
+}
+
+func validateCSRSize(v int) {
+	if v > math.MaxInt32 {
+		panic(fmt.Sprintf("number of vertices %v is more than %v", v, math.MaxInt32))
+	}
+}
+
+// CreateCSRGraph returns a CSRGraph with the same vertices and edges as g.
+func CreateCSRGraph(g Graph) CSRGraph {
+	validateCSRSize(g.V)
+	c := CSRGraph{e: g.E, offsets: make([]int, g.V+1), targets: make([]int32, 0, 2*g.E)}
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			c.targets = append(c.targets, int32(w))
+		}
+		c.offsets[v+1] = len(c.targets)
+	}
+	return c
+}
+
+// CreateCSRGraphFromEdges returns a CSRGraph with v vertices and the given
+// edges, without building a Graph first. The result is the same as adding the
+// edges to a Graph in order: it panics on a self loop, and a repeated edge is
+// added only once.
+func CreateCSRGraphFromEdges(v int, edges [][2]int) CSRGraph {
+	if v < 0 {
+		panic("number of vertices must be non-negative")
+	}
+	validateCSRSize(v)
+	c := CSRGraph{offsets: make([]int, v+1), targets: make([]int32, 2*len(edges))}
+	for _, e := range edges {
+		c.validateVertex(e[0])
+		c.validateVertex(e[1])
+		if e[0] == e[1] {
+			panic("self loops are not allowed")
+		}
+		c.offsets[e[0]+1] = c.offsets[e[0]+1] + 1
+		c.offsets[e[1]+1] = c.offsets[e[1]+1] + 1
+	}
+	for x := 0; x < v; x++ {
+		c.offsets[x+1] = c.offsets[x+1] + c.offsets[x]
+	}
+	// fill the lists in edge order, as AddEdge would
+	next := make([]int, v) // next[x] = index in targets of the next free slot in x's list
+	copy(next, c.offsets)
+	for _, e := range edges {
+		c.targets[next[e[0]]] = int32(e[1])
+		next[e[0]] = next[e[0]] + 1
+		c.targets[next[e[1]]] = int32(e[0])
+		next[e[1]] = next[e[1]] + 1
+	}
+
+	// drop the later copies of repeated edges, compacting the lists in place;
+	// next is reused to mark the neighbours already seen in the current list
+	for x := range next {
+		next[x] = -1
+	}
+	n := 0
+	for x := 0; x < v; x++ {
+		start, end := c.offsets[x], c.offsets[x+1]
+		c.offsets[x] = n
+		for _, w := range c.targets[start:end] {
+			if next[w] != x {
+				next[w] = x
+				c.targets[n] = w
+				n = n + 1
+			}
+		}
+	}
+	c.offsets[v] = n
+	c.targets = c.targets[:n:n]
+	c.e = n / 2
+	return c
+}
+
+func (c CSRGraph) validateVertex(v int) {
+	if v < 0 || v >= c.NumVertices() {
+		msg := fmt.Sprintf("vertex %v is not between 0 and %v", v, c.NumVertices()-1)
+		panic(msg)
+	}
+}
+
+// NumVertices returns the number of vertices in the graph.
+func (c CSRGraph) NumVertices() int {
+	if len(c.offsets) == 0 {
+		return 0 // the zero CSRGraph
+	}
+	return len(c.offsets) - 1
+}
+
+// NumEdges returns the number of edges in the graph.
+func (c CSRGraph) NumEdges() int {
+	return c.e
+}
+
+// Adjacent returns the vertices adjacent to v, in a new slice that the caller
+// may modify.
+func (c CSRGraph) Adjacent(v int) []int {
+	c.validateVertex(v)
+	adj := make([]int, c.offsets[v+1]-c.offsets[v])
+	for i, w := range c.targets[c.offsets[v]:c.offsets[v+1]] {
+		adj[i] = int(w)
+	}
+	return adj
+}
+
+// Undirected marks CSRGraph as satisfying Undirected.
+func (c CSRGraph) Undirected() {}
+
+// Degree returns the degree of vertex v.
+func (c CSRGraph) Degree(v int) int {
+	c.validateVertex(v)
+	return c.offsets[v+1] - c.offsets[v]
+}
+
+// String returns a string representation of the graph, in the same format as
+// Graph.String.
+func (c CSRGraph) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%v vertices; %v edges\n", c.NumVertices(), c.e)
+	for v := 0; v < c.NumVertices(); v++ {
+		fmt.Fprintf(&sb, "%v:", v)
+		for _, w := range c.Adjacent(v) {
+			fmt.Fprintf(&sb, " %v", w)
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,137 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var (
+	_ Adjacency = Graph{}
+	_ Adjacency = Digraph{}
+	_ Adjacency = CSRGraph{}
+
+	_ Undirected = Graph{}
+	_ Undirected = CSRGraph{}
+)
+
+// checkSameGraph verifies that c has the same vertices and adjacency lists as g.
+func checkSameGraph(t *testing.T, g Graph, c CSRGraph) {
+	t.Helper()
+	if c.NumVertices() != g.V || c.NumEdges() != g.E {
+		t.Fatalf("expected %v and %v; got %v and %v", g.V, g.E, c.NumVertices(), c.NumEdges())
+	}
+	for v := 0; v < g.V; v++ {
+		if c.Degree(v) != g.Degree(v) {
+			t.Errorf("expected %v; got %v", g.Degree(v), c.Degree(v))
+		}
+		if len(g.Adj[v]) > 0 && !reflect.DeepEqual(c.Adjacent(v), g.Adj[v]) {
+			t.Errorf("expected %v; got %v", g.Adj[v], c.Adjacent(v))
+		}
+	}
+	if c.String() != g.String() {
+		t.Errorf("expected %v; got %v", g.String(), c.String())
+	}
+}
+
+func TestCreateCSRGraph(t *testing.T) {
+	gen := NewGraphGenerator(rand.New(rand.NewSource(1)))
+	testCases := []struct {
+		name string
+		g    Graph
+	}{
+		{"empty", CreateGraph(0)},
+		{"isolated", CreateGraph(5)},
+		{"tree", gen.Tree(100)},
+		{"sparse", gen.Simple(200, 300)},
+		{"grid", gen.Grid(10, 10)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checkSameGraph(t, tc.g, CreateCSRGraph(tc.g))
+		})
+	}
+}
+
+func TestCreateCSRGraphFromEdges(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		v := 1 + r.Intn(30)
+		g := CreateGraph(v)
+		var edges [][2]int
+		// include repeated edges in both orders
+		for j := 0; j < 2*v; j++ {
+			a, b := r.Intn(v), r.Intn(v)
+			if a != b {
+				g.AddEdge(a, b)
+				edges = append(edges, [2]int{a, b})
+			}
+		}
+		checkSameGraph(t, g, CreateCSRGraphFromEdges(v, edges))
+	}
+}
+
+func TestCSRGraphAdjacentIsCopied(t *testing.T) {
+	c := CreateCSRGraphFromEdges(3, [][2]int{{0, 1}, {1, 2}})
+	// changing or appending to one list mustn't change the graph
+	adj := c.Adjacent(1)
+	adj[0] = 2
+	adj = append(c.Adjacent(0), 2)
+	if !reflect.DeepEqual(c.Adjacent(1), []int{0, 2}) {
+		t.Errorf("expected %v; got %v (after appending to get %v)", []int{0, 2}, c.Adjacent(1), adj)
+	}
+}
+
+func TestCSRGraphPanics(t *testing.T) {
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{"self loop", func() { CreateCSRGraphFromEdges(2, [][2]int{{1, 1}}) }},
+		{"out of range", func() { CreateCSRGraphFromEdges(2, [][2]int{{0, 2}}) }},
+		{"negative vertices", func() { CreateCSRGraphFromEdges(-1, nil) }},
+		{"too many vertices", func() { CreateCSRGraphFromEdges(math.MaxInt32+1, nil) }},
+		{"invalid vertex", func() { CreateCSRGraphFromEdges(2, nil).Adjacent(2) }},
+		{"zero value", func() { CSRGraph{}.Degree(0) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic")
+				}
+			}()
+			tc.f()
+		})
+	}
+}
+
+func ExampleCSRGraph() {
+	c := CreateCSRGraphFromEdges(4, [][2]int{{0, 1}, {0, 2}, {2, 3}, {1, 0}})
+	fmt.Print(c.String())
+	fmt.Println(c.Degree(0), c.Adjacent(2))
+	// Output:
+	// 4 vertices; 3 edges
+	// 0: 1 2
+	// 1: 0
+	// 2: 0 3
+	// 3: 2
+	// 2 [0 3]
+}
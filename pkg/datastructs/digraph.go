@@ -0,0 +1,128 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Digraph represents a directed graph of vertices named 0 through V – 1.
+// Self loops are allowed, but multiple (or parallel) edges are disallowed.
+type Digraph struct {
+	V        int
+	E        int
+	Adj      [][]int
+	indegree []int // indegree[v] = number of edges pointing to v
+}
+
+// CreateDigraph initializes an empty digraph with v vertices and 0 edges.
+func CreateDigraph(v int) Digraph {
+	if v < 0 {
+		panic("number of vertices must be non-negative")
+	}
+	g := Digraph{}
+	g.V = v
+	g.E = 0
+	g.Adj = make([][]int, v)
+	g.indegree = make([]int, v)
+	return g
+}
+
+func (g *Digraph) validateVertex(v int) {
+	if v < 0 || v >= g.V {
+		msg := fmt.Sprintf("vertex %v is not between 0 and %v", v, g.V-1)
+		panic(msg)
+	}
+}
+
+func (g *Digraph) edgeExists(v int, w int) bool {
+	for _, vtx := range g.Adj[v] {
+		if vtx == w {
+			return true
+		}
+	}
+	return false
+}
+
+// AddEdge adds the directed edge v->w to the digraph.
+func (g *Digraph) AddEdge(v int, w int) {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	// disallow multiple (or parallel) edges
+	if g.edgeExists(v, w) {
+		return
+	}
+	g.E = g.E + 1
+	g.Adj[v] = append(g.Adj[v], w)
+	g.indegree[w] = g.indegree[w] + 1
+}
+
+// NumVertices returns the number of vertices in the digraph. It and Adjacent
+// have value receivers, so that a Digraph value satisfies Adjacency.
+func (g Digraph) NumVertices() int {
+	return g.V
+}
+
+// Adjacent returns the vertices that v points to. The caller must not modify
+// the returned slice.
+func (g Digraph) Adjacent(v int) []int {
+	g.validateVertex(v)
+	return g.Adj[v]
+}
+
+// Outdegree returns the number of directed edges incident from vertex v.
+func (g *Digraph) Outdegree(v int) int {
+	g.validateVertex(v)
+	return len(g.Adj[v])
+}
+
+// Indegree returns the number of directed edges incident to vertex v.
+func (g *Digraph) Indegree(v int) int {
+	g.validateVertex(v)
+	return g.indegree[v]
+}
+
+// Reverse returns the reverse of the digraph, i.e. a digraph with the same
+// vertices and every edge v->w replaced by w->v.
+func (g *Digraph) Reverse() Digraph {
+	r := CreateDigraph(g.V)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			// the reverse of a digraph without parallel edges has none either,
+			// so there's no need for the duplicate check in AddEdge
+			r.Adj[w] = append(r.Adj[w], v)
+			r.indegree[v] = r.indegree[v] + 1
+		}
+	}
+	r.E = g.E
+	return r
+}
+
+// String returns a string representation of the digraph.
+func (g *Digraph) String() string {
+	s := fmt.Sprintf("%v vertices; %v edges\n", g.V, g.E)
+	for v := 0; v < g.V; v++ {
+		s = s + fmt.Sprintf("%v: ", v)
+		for _, w := range g.Adj[v] {
+			s = s + fmt.Sprintf("%v ", w)
+		}
+		s = strings.TrimSpace(s)
+		s = s + "\n"
+	}
+
+	return s
+}
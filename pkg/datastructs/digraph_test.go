@@ -0,0 +1,146 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCreateDigraph(t *testing.T) {
+	g := CreateDigraph(7)
+	if g.E != 0 {
+		t.Errorf("expected %v; got %v", 0, g.E)
+	}
+	if g.V != 7 {
+		t.Errorf("expected %v; got %v", 7, g.V)
+	}
+	if len(g.Adj) != 7 {
+		t.Errorf("expected %v; got %v", 7, g.Adj)
+	}
+	for v := 0; v < g.V; v++ {
+		if g.Indegree(v) != 0 {
+			t.Errorf("expected %v; got %v", 0, g.Indegree(v))
+		}
+		if g.Outdegree(v) != 0 {
+			t.Errorf("expected %v; got %v", 0, g.Outdegree(v))
+		}
+	}
+}
+
+func TestDigraphAddEdge(t *testing.T) {
+	g := CreateDigraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(3, 3) // self loops are allowed
+	g.AddEdge(0, 1) // this should be a noop
+
+	if g.E != 5 {
+		t.Errorf("expected %v; got %v", 5, g.E)
+	}
+
+	testCases := []struct {
+		name      string
+		adjList   []int
+		indegree  int
+		outdegree int
+	}{
+		{"0", []int{1, 2}, 1, 2},
+		{"1", []int{2}, 1, 1},
+		{"2", []int{0}, 2, 1},
+		{"3", []int{3}, 1, 1},
+		{"4", nil, 0, 0},
+	}
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !reflect.DeepEqual(g.Adj[i], tc.adjList) {
+				t.Errorf("expected %v; got %v", tc.adjList, g.Adj[i])
+			}
+			if g.Indegree(i) != tc.indegree {
+				t.Errorf("expected %v; got %v", tc.indegree, g.Indegree(i))
+			}
+			if g.Outdegree(i) != tc.outdegree {
+				t.Errorf("expected %v; got %v", tc.outdegree, g.Outdegree(i))
+			}
+		})
+	}
+}
+
+func TestDigraphReverse(t *testing.T) {
+	g := CreateDigraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+	r := g.Reverse()
+
+	if r.V != g.V {
+		t.Errorf("expected %v; got %v", g.V, r.V)
+	}
+	if r.E != g.E {
+		t.Errorf("expected %v; got %v", g.E, r.E)
+	}
+	want := [][]int{{3}, {0}, {0}, {2}}
+	if !reflect.DeepEqual(r.Adj, want) {
+		t.Errorf("expected %v; got %v", want, r.Adj)
+	}
+	for v := 0; v < g.V; v++ {
+		if r.Indegree(v) != g.Outdegree(v) {
+			t.Errorf("expected %v; got %v", g.Outdegree(v), r.Indegree(v))
+		}
+		if r.Outdegree(v) != g.Indegree(v) {
+			t.Errorf("expected %v; got %v", g.Indegree(v), r.Outdegree(v))
+		}
+	}
+}
+
+func TestDigraphAdjacency(t *testing.T) {
+	g := CreateDigraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	var a Adjacency = g
+	if a.NumVertices() != 3 {
+		t.Errorf("expected %v; got %v", 3, a.NumVertices())
+	}
+	if !reflect.DeepEqual(a.Adjacent(0), []int{1, 2}) {
+		t.Errorf("expected %v; got %v", []int{1, 2}, a.Adjacent(0))
+	}
+}
+
+func ExampleDigraph() {
+	g := CreateDigraph(6)
+	g.AddEdge(0, 5)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 5)
+	g.AddEdge(3, 2)
+	g.AddEdge(4, 3)
+	g.AddEdge(4, 2)
+	g.AddEdge(5, 4)
+	fmt.Print(g.String())
+	// Output:
+	// 6 vertices; 9 edges
+	// 0: 5 1
+	// 1:
+	// 2: 0 3
+	// 3: 5 2
+	// 4: 3 2
+	// 5: 4
+}
@@ -0,0 +1,30 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import "fmt"
+
+// DirectedEdge represents a weighted edge From->To in an EdgeWeightedDigraph.
+type DirectedEdge struct {
+	From   int
+	To     int
+	Weight float64
+}
+
+// String returns a string representation of the directed edge.
+func (e DirectedEdge) String() string {
+	return fmt.Sprintf("%v->%v %.5f", e.From, e.To, e.Weight)
+}
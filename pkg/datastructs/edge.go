@@ -0,0 +1,47 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import "fmt"
+
+// Edge represents a weighted edge in an EdgeWeightedGraph. The endpoints V and
+// W are interchangeable; the edge has no direction.
+type Edge struct {
+	V      int
+	W      int
+	Weight float64
+}
+
+// Either returns either one of the edge's endpoints.
+func (e Edge) Either() int {
+	return e.V
+}
+
+// Other returns the endpoint of the edge that is different from vertex v.
+func (e Edge) Other(v int) int {
+	if v == e.V {
+		return e.W
+	}
+	if v == e.W {
+		return e.V
+	}
+	panic(fmt.Sprintf("vertex %v is not an endpoint of edge %v", v, e))
+}
+
+// String returns a string representation of the edge.
+func (e Edge) String() string {
+	return fmt.Sprintf("%v-%v %.5f", e.V, e.W, e.Weight)
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestEdge(t *testing.T) {
+	e := Edge{V: 4, W: 7, Weight: 0.37}
+	if e.Either() != 4 {
+		t.Errorf("expected %v; got %v", 4, e.Either())
+	}
+	if e.Other(4) != 7 {
+		t.Errorf("expected %v; got %v", 7, e.Other(4))
+	}
+	if e.Other(7) != 4 {
+		t.Errorf("expected %v; got %v", 4, e.Other(7))
+	}
+}
+
+func TestEdgeOtherPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected Other to panic on a vertex that isn't an endpoint")
+		}
+	}()
+	e := Edge{V: 4, W: 7, Weight: 0.37}
+	e.Other(5)
+}
+
+func ExampleEdge() {
+	e := Edge{V: 12, W: 34, Weight: 5.67}
+	fmt.Println(e)
+	// Output:
+	// 12-34 5.67000
+}
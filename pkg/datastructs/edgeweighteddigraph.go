@@ -0,0 +1,109 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EdgeWeightedDigraph represents a directed graph of vertices named 0 through
+// V – 1, where each directed edge has a real-valued weight. Self loops and
+// parallel edges are allowed.
+type EdgeWeightedDigraph struct {
+	V        int
+	E        int
+	Adj      [][]DirectedEdge
+	indegree []int // indegree[v] = number of edges pointing to v
+}
+
+// CreateEdgeWeightedDigraph initializes an empty edge-weighted digraph with v
+// vertices and 0 edges.
+func CreateEdgeWeightedDigraph(v int) EdgeWeightedDigraph {
+	if v < 0 {
+		panic("number of vertices must be non-negative")
+	}
+	g := EdgeWeightedDigraph{}
+	g.V = v
+	g.E = 0
+	g.Adj = make([][]DirectedEdge, v)
+	g.indegree = make([]int, v)
+	return g
+}
+
+func (g *EdgeWeightedDigraph) validateVertex(v int) {
+	if v < 0 || v >= g.V {
+		msg := fmt.Sprintf("vertex %v is not between 0 and %v", v, g.V-1)
+		panic(msg)
+	}
+}
+
+// AddEdge adds the directed edge e to the digraph.
+func (g *EdgeWeightedDigraph) AddEdge(e DirectedEdge) {
+	g.validateVertex(e.From)
+	g.validateVertex(e.To)
+	g.E = g.E + 1
+	g.Adj[e.From] = append(g.Adj[e.From], e)
+	g.indegree[e.To] = g.indegree[e.To] + 1
+}
+
+// Outdegree returns the number of directed edges incident from vertex v.
+func (g *EdgeWeightedDigraph) Outdegree(v int) int {
+	g.validateVertex(v)
+	return len(g.Adj[v])
+}
+
+// Indegree returns the number of directed edges incident to vertex v.
+func (g *EdgeWeightedDigraph) Indegree(v int) int {
+	g.validateVertex(v)
+	return g.indegree[v]
+}
+
+// Edges returns all of the directed edges in the digraph.
+func (g *EdgeWeightedDigraph) Edges() []DirectedEdge {
+	edges := make([]DirectedEdge, 0, g.E)
+	for v := 0; v < g.V; v++ {
+		edges = append(edges, g.Adj[v]...)
+	}
+	return edges
+}
+
+// Reverse returns the reverse of the digraph, i.e. a digraph with the same
+// vertices and every edge v->w replaced by w->v with the same weight.
+func (g *EdgeWeightedDigraph) Reverse() EdgeWeightedDigraph {
+	r := CreateEdgeWeightedDigraph(g.V)
+	for v := 0; v < g.V; v++ {
+		for _, e := range g.Adj[v] {
+			r.AddEdge(DirectedEdge{From: e.To, To: e.From, Weight: e.Weight})
+		}
+	}
+	return r
+}
+
+// String returns a string representation of the digraph.
+func (g *EdgeWeightedDigraph) String() string {
+	s := fmt.Sprintf("%v vertices; %v edges\n", g.V, g.E)
+	for v := 0; v < g.V; v++ {
+		s = s + fmt.Sprintf("%v: ", v)
+		for _, e := range g.Adj[v] {
+			s = s + fmt.Sprintf("%v  ", e)
+		}
+		s = strings.TrimSpace(s)
+		s = s + "\n"
+	}
+
+	return s
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCreateEdgeWeightedDigraph(t *testing.T) {
+	g := CreateEdgeWeightedDigraph(5)
+	if g.E != 0 {
+		t.Errorf("expected %v; got %v", 0, g.E)
+	}
+	if g.V != 5 {
+		t.Errorf("expected %v; got %v", 5, g.V)
+	}
+	if len(g.Adj) != 5 {
+		t.Errorf("expected %v; got %v", 5, g.Adj)
+	}
+}
+
+func TestEdgeWeightedDigraphAddEdge(t *testing.T) {
+	g := CreateEdgeWeightedDigraph(4)
+	g.AddEdge(DirectedEdge{0, 1, 0.5})
+	g.AddEdge(DirectedEdge{1, 2, 0.25})
+	g.AddEdge(DirectedEdge{2, 0, 1.5})
+	g.AddEdge(DirectedEdge{0, 1, 0.75}) // parallel edges are allowed
+	g.AddEdge(DirectedEdge{3, 3, 0.1})  // so are self loops
+
+	if g.E != 5 {
+		t.Errorf("expected %v; got %v", 5, g.E)
+	}
+
+	testCases := []struct {
+		name      string
+		indegree  int
+		outdegree int
+	}{
+		{"0", 1, 2},
+		{"1", 2, 1},
+		{"2", 1, 1},
+		{"3", 1, 1},
+	}
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if g.Indegree(i) != tc.indegree {
+				t.Errorf("expected %v; got %v", tc.indegree, g.Indegree(i))
+			}
+			if g.Outdegree(i) != tc.outdegree {
+				t.Errorf("expected %v; got %v", tc.outdegree, g.Outdegree(i))
+			}
+		})
+	}
+
+	want := []DirectedEdge{{0, 1, 0.5}, {0, 1, 0.75}, {1, 2, 0.25}, {2, 0, 1.5}, {3, 3, 0.1}}
+	if !reflect.DeepEqual(g.Edges(), want) {
+		t.Errorf("expected %v; got %v", want, g.Edges())
+	}
+}
+
+func TestEdgeWeightedDigraphReverse(t *testing.T) {
+	g := CreateEdgeWeightedDigraph(3)
+	g.AddEdge(DirectedEdge{0, 1, 0.5})
+	g.AddEdge(DirectedEdge{0, 2, 0.25})
+	g.AddEdge(DirectedEdge{2, 1, 1.5})
+	g.AddEdge(DirectedEdge{2, 2, 0.1})
+	r := g.Reverse()
+
+	if r.V != g.V {
+		t.Errorf("expected %v; got %v", g.V, r.V)
+	}
+	if r.E != g.E {
+		t.Errorf("expected %v; got %v", g.E, r.E)
+	}
+	want := [][]DirectedEdge{nil, {{1, 0, 0.5}, {1, 2, 1.5}}, {{2, 0, 0.25}, {2, 2, 0.1}}}
+	if !reflect.DeepEqual(r.Adj, want) {
+		t.Errorf("expected %v; got %v", want, r.Adj)
+	}
+	for v := 0; v < g.V; v++ {
+		if r.Indegree(v) != g.Outdegree(v) {
+			t.Errorf("expected %v; got %v", g.Outdegree(v), r.Indegree(v))
+		}
+	}
+}
+
+func ExampleEdgeWeightedDigraph() {
+	g := CreateEdgeWeightedDigraph(4)
+	g.AddEdge(DirectedEdge{0, 1, 0.5})
+	g.AddEdge(DirectedEdge{1, 2, 0.25})
+	g.AddEdge(DirectedEdge{2, 0, 1.5})
+	g.AddEdge(DirectedEdge{0, 2, 2})
+	fmt.Print(g.String())
+	// Output:
+	// 4 vertices; 4 edges
+	// 0: 0->1 0.50000  0->2 2.00000
+	// 1: 1->2 0.25000
+	// 2: 2->0 1.50000
+	// 3:
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EdgeWeightedGraph represents an undirected graph of vertices named 0 through
+// V – 1, where each edge has a real-valued weight. Parallel edges are allowed,
+// but self loops are disallowed.
+type EdgeWeightedGraph struct {
+	V   int
+	E   int
+	Adj [][]Edge
+}
+
+// CreateEdgeWeightedGraph initializes an empty edge-weighted graph with v
+// vertices and 0 edges.
+func CreateEdgeWeightedGraph(v int) EdgeWeightedGraph {
+	if v < 0 {
+		panic("number of vertices must be non-negative")
+	}
+	g := EdgeWeightedGraph{}
+	g.V = v
+	g.E = 0
+	g.Adj = make([][]Edge, v)
+	return g
+}
+
+func (g *EdgeWeightedGraph) validateVertex(v int) {
+	if v < 0 || v >= g.V {
+		msg := fmt.Sprintf("vertex %v is not between 0 and %v", v, g.V-1)
+		panic(msg)
+	}
+}
+
+// AddEdge adds the undirected edge e to the graph.
+func (g *EdgeWeightedGraph) AddEdge(e Edge) {
+	g.validateVertex(e.V)
+	g.validateVertex(e.W)
+	// disallow self loops
+	if e.V == e.W {
+		panic("self loops are not allowed")
+	}
+	g.E = g.E + 1
+	g.Adj[e.V] = append(g.Adj[e.V], e)
+	g.Adj[e.W] = append(g.Adj[e.W], e)
+}
+
+// Degree returns the degree of vertex v.
+func (g *EdgeWeightedGraph) Degree(v int) int {
+	g.validateVertex(v)
+	return len(g.Adj[v])
+}
+
+// Edges returns all of the edges in the graph. Each edge appears once, in the
+// adjacency list of its lower-numbered endpoint.
+func (g *EdgeWeightedGraph) Edges() []Edge {
+	edges := make([]Edge, 0, g.E)
+	for v := 0; v < g.V; v++ {
+		for _, e := range g.Adj[v] {
+			if e.Other(v) > v {
+				edges = append(edges, e)
+			}
+		}
+	}
+	return edges
+}
+
+// String returns a string representation of the graph.
+func (g *EdgeWeightedGraph) String() string {
+	s := fmt.Sprintf("%v vertices; %v edges\n", g.V, g.E)
+	for v := 0; v < g.V; v++ {
+		s = s + fmt.Sprintf("%v: ", v)
+		for _, e := range g.Adj[v] {
+			s = s + fmt.Sprintf("%v  ", e)
+		}
+		s = strings.TrimSpace(s)
+		s = s + "\n"
+	}
+
+	return s
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCreateEdgeWeightedGraph(t *testing.T) {
+	g := CreateEdgeWeightedGraph(5)
+	if g.E != 0 {
+		t.Errorf("expected %v; got %v", 0, g.E)
+	}
+	if g.V != 5 {
+		t.Errorf("expected %v; got %v", 5, g.V)
+	}
+	if len(g.Adj) != 5 {
+		t.Errorf("expected %v; got %v", 5, g.Adj)
+	}
+}
+
+func TestEdgeWeightedGraphAddEdge(t *testing.T) {
+	g := CreateEdgeWeightedGraph(4)
+	g.AddEdge(Edge{0, 1, 0.5})
+	g.AddEdge(Edge{1, 2, 0.25})
+	g.AddEdge(Edge{2, 0, 1.5})
+	g.AddEdge(Edge{0, 1, 0.75}) // parallel edges are allowed
+
+	if g.E != 4 {
+		t.Errorf("expected %v; got %v", 4, g.E)
+	}
+
+	testCases := []struct {
+		name   string
+		degree int
+	}{
+		{"0", 3},
+		{"1", 3},
+		{"2", 2},
+		{"3", 0},
+	}
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if g.Degree(i) != tc.degree {
+				t.Errorf("expected %v; got %v", tc.degree, g.Degree(i))
+			}
+		})
+	}
+
+	want := []Edge{{0, 1, 0.5}, {0, 1, 0.75}, {1, 2, 0.25}, {2, 0, 1.5}}
+	got := g.Edges()
+	if len(got) != g.E {
+		t.Errorf("expected %v; got %v", g.E, len(got))
+	}
+	// Edges lists each edge under its lower-numbered endpoint
+	for _, e := range want {
+		found := false
+		for _, f := range got {
+			if reflect.DeepEqual(e, f) {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected %v in %v", e, got)
+		}
+	}
+}
+
+func TestEdgeWeightedGraphSelfLoop(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected AddEdge to panic on a self loop")
+		}
+	}()
+	g := CreateEdgeWeightedGraph(2)
+	g.AddEdge(Edge{1, 1, 0.5})
+}
+
+func ExampleEdgeWeightedGraph() {
+	g := CreateEdgeWeightedGraph(4)
+	g.AddEdge(Edge{0, 1, 0.5})
+	g.AddEdge(Edge{1, 2, 0.25})
+	g.AddEdge(Edge{2, 0, 1.5})
+	fmt.Print(g.String())
+	// Output:
+	// 4 vertices; 3 edges
+	// 0: 0-1 0.50000  2-0 1.50000
+	// 1: 0-1 0.50000  1-2 0.25000
+	// 2: 1-2 0.25000  2-0 1.50000
+	// 3:
+}
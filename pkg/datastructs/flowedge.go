@@ -0,0 +1,83 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import "fmt"
+
+// FlowEdge represents a capacitated edge From->To with a flow in a FlowNetwork.
+// It's shared by the adjacency lists of both of its endpoints, so it should be
+// created with NewFlowEdge and handled by pointer.
+type FlowEdge struct {
+	From     int
+	To       int
+	Capacity float64
+	Flow     float64
+}
+
+// NewFlowEdge returns a new edge from->to with the given capacity and no flow.
+func NewFlowEdge(from int, to int, capacity float64) *FlowEdge {
+	if from < 0 || to < 0 {
+		panic("vertex index must be non-negative")
+	}
+	if !(capacity >= 0) {
+		panic("edge capacity must be non-negative")
+	}
+	return &FlowEdge{From: from, To: to, Capacity: capacity}
+}
+
+// Other returns the endpoint of the edge that is different from vertex v.
+func (e *FlowEdge) Other(v int) int {
+	if v == e.From {
+		return e.To
+	}
+	if v == e.To {
+		return e.From
+	}
+	panic(fmt.Sprintf("vertex %v is not an endpoint of edge %v", v, e))
+}
+
+// ResidualCapacityTo returns the residual capacity of the edge in the direction
+// of vertex v: the flow that can still be added towards To, or removed towards
+// From.
+func (e *FlowEdge) ResidualCapacityTo(v int) float64 {
+	if v == e.From {
+		return e.Flow // backward edge
+	}
+	if v == e.To {
+		return e.Capacity - e.Flow // forward edge
+	}
+	panic(fmt.Sprintf("vertex %v is not an endpoint of edge %v", v, e))
+}
+
+// AddResidualFlowTo changes the flow on the edge by delta in the direction of
+// vertex v: it increases the flow if v is To, and decreases it if v is From.
+func (e *FlowEdge) AddResidualFlowTo(v int, delta float64) {
+	if !(delta >= 0) {
+		panic("delta must be non-negative")
+	}
+	if v == e.From {
+		e.Flow = e.Flow - delta // backward edge
+	} else if v == e.To {
+		e.Flow = e.Flow + delta // forward edge
+	} else {
+		panic(fmt.Sprintf("vertex %v is not an endpoint of edge %v", v, e))
+	}
+}
+
+// String returns a string representation of the edge.
+func (e *FlowEdge) String() string {
+	return fmt.Sprintf("%v->%v %v/%v", e.From, e.To, e.Flow, e.Capacity)
+}
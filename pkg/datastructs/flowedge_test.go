@@ -0,0 +1,67 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFlowEdge(t *testing.T) {
+	e := NewFlowEdge(1, 2, 5)
+	if e.Other(1) != 2 {
+		t.Errorf("expected %v; got %v", 2, e.Other(1))
+	}
+	if e.ResidualCapacityTo(2) != 5 {
+		t.Errorf("expected %v; got %v", 5, e.ResidualCapacityTo(2))
+	}
+	if e.ResidualCapacityTo(1) != 0 {
+		t.Errorf("expected %v; got %v", 0, e.ResidualCapacityTo(1))
+	}
+
+	e.AddResidualFlowTo(2, 3) // push 3 units forward
+	if e.Flow != 3 {
+		t.Errorf("expected %v; got %v", 3, e.Flow)
+	}
+	if e.ResidualCapacityTo(2) != 2 {
+		t.Errorf("expected %v; got %v", 2, e.ResidualCapacityTo(2))
+	}
+	if e.ResidualCapacityTo(1) != 3 {
+		t.Errorf("expected %v; got %v", 3, e.ResidualCapacityTo(1))
+	}
+
+	e.AddResidualFlowTo(1, 1) // cancel 1 unit
+	if e.Flow != 2 {
+		t.Errorf("expected %v; got %v", 2, e.Flow)
+	}
+}
+
+func TestNewFlowEdgeNegativeCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected NewFlowEdge to panic on a negative capacity")
+		}
+	}()
+	NewFlowEdge(0, 1, -1)
+}
+
+func ExampleFlowEdge() {
+	e := NewFlowEdge(0, 1, 2.5)
+	e.AddResidualFlowTo(1, 1.5)
+	fmt.Println(e)
+	// Output:
+	// 0->1 1.5/2.5
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FlowNetwork represents a capacitated network of vertices named 0 through
+// V – 1. Each edge is stored in the adjacency lists of both of its endpoints,
+// so that algorithms can follow it in either direction in the residual network.
+// Parallel edges are allowed, but self loops are disallowed.
+type FlowNetwork struct {
+	V   int
+	E   int
+	Adj [][]*FlowEdge
+}
+
+// CreateFlowNetwork initializes an empty flow network with v vertices and 0 edges.
+func CreateFlowNetwork(v int) FlowNetwork {
+	if v < 0 {
+		panic("number of vertices must be non-negative")
+	}
+	g := FlowNetwork{}
+	g.V = v
+	g.E = 0
+	g.Adj = make([][]*FlowEdge, v)
+	return g
+}
+
+func (g *FlowNetwork) validateVertex(v int) {
+	if v < 0 || v >= g.V {
+		msg := fmt.Sprintf("vertex %v is not between 0 and %v", v, g.V-1)
+		panic(msg)
+	}
+}
+
+// AddEdge adds the edge e to the network.
+func (g *FlowNetwork) AddEdge(e *FlowEdge) {
+	g.validateVertex(e.From)
+	g.validateVertex(e.To)
+	// disallow self loops
+	if e.From == e.To {
+		panic("self loops are not allowed")
+	}
+	g.E = g.E + 1
+	g.Adj[e.From] = append(g.Adj[e.From], e)
+	g.Adj[e.To] = append(g.Adj[e.To], e)
+}
+
+// Edges returns all of the edges in the network. Each edge appears once, in
+// the adjacency list of its From vertex.
+func (g *FlowNetwork) Edges() []*FlowEdge {
+	edges := make([]*FlowEdge, 0, g.E)
+	for v := 0; v < g.V; v++ {
+		for _, e := range g.Adj[v] {
+			if e.From == v {
+				edges = append(edges, e)
+			}
+		}
+	}
+	return edges
+}
+
+// String returns a string representation of the network.
+func (g *FlowNetwork) String() string {
+	s := fmt.Sprintf("%v vertices; %v edges\n", g.V, g.E)
+	for v := 0; v < g.V; v++ {
+		s = s + fmt.Sprintf("%v: ", v)
+		for _, e := range g.Adj[v] {
+			if e.From == v {
+				s = s + fmt.Sprintf("%v  ", e)
+			}
+		}
+		s = strings.TrimSpace(s)
+		s = s + "\n"
+	}
+
+	return s
+}
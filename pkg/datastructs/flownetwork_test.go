@@ -0,0 +1,72 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFlowNetwork(t *testing.T) {
+	g := CreateFlowNetwork(4)
+	g.AddEdge(NewFlowEdge(0, 1, 2))
+	g.AddEdge(NewFlowEdge(0, 2, 3))
+	g.AddEdge(NewFlowEdge(1, 3, 1))
+	g.AddEdge(NewFlowEdge(2, 3, 4))
+
+	if g.E != 4 {
+		t.Errorf("expected %v; got %v", 4, g.E)
+	}
+	// each edge is in the adjacency lists of both endpoints
+	if len(g.Adj[3]) != 2 {
+		t.Errorf("expected %v; got %v", 2, len(g.Adj[3]))
+	}
+	if g.Adj[0][0] != g.Adj[1][0] {
+		t.Errorf("expected both endpoints to share edge %v", g.Adj[0][0])
+	}
+	edges := g.Edges()
+	if len(edges) != 4 {
+		t.Errorf("expected %v; got %v", 4, len(edges))
+	}
+	for _, e := range edges {
+		if e.Flow != 0 {
+			t.Errorf("expected %v; got %v", 0, e.Flow)
+		}
+	}
+}
+
+func TestFlowNetworkSelfLoop(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected AddEdge to panic on a self loop")
+		}
+	}()
+	g := CreateFlowNetwork(2)
+	g.AddEdge(NewFlowEdge(1, 1, 2))
+}
+
+func ExampleFlowNetwork() {
+	g := CreateFlowNetwork(3)
+	g.AddEdge(NewFlowEdge(0, 1, 2))
+	g.AddEdge(NewFlowEdge(0, 2, 3))
+	g.AddEdge(NewFlowEdge(1, 2, 1))
+	fmt.Print(g.String())
+	// Output:
+	// 3 vertices; 3 edges
+	// 0: 0->1 0/2  0->2 0/3
+	// 1: 1->2 0/1
+	// 2:
+}
@@ -21,13 +21,20 @@ import (
 )
 
 // Graph represents a simple undirected graph of vertices named 0 through V – 1.
-// Multiple edges and self loops are disallowed.
+// Multiple edges and self loops are disallowed. Vertices and edges can be added
+// and removed, HasEdge takes constant time, and removing an edge leaves the
+// order of the other neighbours unchanged.
 type Graph struct {
 	V   int
 	E   int
 	Adj [][]int
+	pos []map[int]int // pos[v][w] = index of w in Adj[v], or nil if v isn't indexed
 }
 
+// indexDegree is the degree above which a vertex's adjacency list is indexed
+// in pos.
+const indexDegree = 8
+
 // CreateGraph initializes an empty graph with v vertices and 0 edges.
 func CreateGraph(v int) Graph {
 	if v < 0 {
@@ -37,6 +44,7 @@ func CreateGraph(v int) Graph {
 	g.V = v
 	g.E = 0
 	g.Adj = make([][]int, v)
+	g.pos = make([]map[int]int, v)
 	return g
 }
 
@@ -47,14 +55,72 @@ func (g *Graph) validateVertex(v int) {
 	}
 }
 
+// growPos extends pos to one entry per vertex, for graphs that weren't built
+// with CreateGraph. Only the methods that change the graph call it, so that
+// queries never write to the graph.
+func (g *Graph) growPos() {
+	if len(g.pos) < g.V {
+		g.pos = append(g.pos, make([]map[int]int, g.V-len(g.pos))...)
+	}
+}
+
+// find returns the position of w in Adj[v], or -1 if v-w isn't an edge. It
+// scans Adj[v] if v isn't indexed.
+func (g *Graph) find(v int, w int) int {
+	if v < len(g.pos) && g.pos[v] != nil {
+		if i, ok := g.pos[v][w]; ok {
+			return i
+		}
+		return -1
+	}
+	for i, x := range g.Adj[v] {
+		if x == w {
+			return i
+		}
+	}
+	return -1
+}
+
 func (g *Graph) edgeExists(v int, w int) bool {
-	result := false
-	for _, vtx := range g.Adj[v] {
-		if vtx == w {
-			result = true
+	return g.find(v, w) != -1
+}
+
+// appendAdj adds w to the end of Adj[v], indexing v once its degree passes
+// indexDegree.
+func (g *Graph) appendAdj(v int, w int) {
+	g.Adj[v] = append(g.Adj[v], w)
+	if m := g.pos[v]; m != nil {
+		m[w] = len(g.Adj[v]) - 1
+	} else if len(g.Adj[v]) > indexDegree {
+		m = make(map[int]int, len(g.Adj[v]))
+		for i, x := range g.Adj[v] {
+			m[x] = i
 		}
+		g.pos[v] = m
+	}
+}
+
+// removeAdj removes w from Adj[v] by shifting the vertices after it down one
+// place, so the order of the rest of the list is unchanged. It takes time
+// proportional to the number of vertices after w.
+func (g *Graph) removeAdj(v int, w int) {
+	i := g.find(v, w)
+	adj := g.Adj[v]
+	copy(adj[i:], adj[i+1:])
+	g.Adj[v] = adj[:len(adj)-1]
+	m := g.pos[v]
+	if m == nil {
+		return
+	}
+	if len(g.Adj[v]) <= indexDegree {
+		// short enough to scan again, so free the map
+		g.pos[v] = nil
+		return
+	}
+	delete(m, w)
+	for j := i; j < len(g.Adj[v]); j++ {
+		m[g.Adj[v][j]] = j
 	}
-	return result
 }
 
 // AddEdge adds the undirected edge v-w to the graph.
@@ -69,11 +135,90 @@ func (g *Graph) AddEdge(v int, w int) {
 	if g.edgeExists(v, w) {
 		return
 	}
+	g.growPos()
 	g.E = g.E + 1
-	g.Adj[v] = append(g.Adj[v], w)
-	g.Adj[w] = append(g.Adj[w], v)
+	g.appendAdj(v, w)
+	g.appendAdj(w, v)
+}
+
+// HasEdge returns true if the graph has the edge v-w.
+func (g *Graph) HasEdge(v int, w int) bool {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	return g.edgeExists(v, w)
+}
+
+// RemoveEdge removes the edge v-w from the graph. If there is no such edge,
+// it's a noop. The other neighbours of v and w keep their order.
+func (g *Graph) RemoveEdge(v int, w int) {
+	g.validateVertex(v)
+	g.validateVertex(w)
+	if !g.edgeExists(v, w) {
+		return
+	}
+	g.growPos()
+	g.E = g.E - 1
+	g.removeAdj(v, w)
+	g.removeAdj(w, v)
+}
+
+// AddVertex adds a vertex with no edges to the graph and returns it. The new
+// vertex is V – 1, after V has been incremented.
+func (g *Graph) AddVertex() int {
+	g.growPos()
+	g.V = g.V + 1
+	g.Adj = append(g.Adj, nil)
+	g.pos = append(g.pos, nil)
+	return g.V - 1
 }
 
+// RemoveVertex removes vertex v and all of its edges from the graph. The last
+// vertex is renumbered v.
+func (g *Graph) RemoveVertex(v int) {
+	g.validateVertex(v)
+	g.growPos()
+	for _, w := range g.Adj[v] {
+		g.removeAdj(w, v)
+	}
+	g.E = g.E - len(g.Adj[v])
+
+	last := g.V - 1
+	if v != last {
+		// rename last to v in its neighbours' lists, keeping their order
+		for _, w := range g.Adj[last] {
+			i := g.find(w, last)
+			g.Adj[w][i] = v
+			if m := g.pos[w]; m != nil {
+				delete(m, last)
+				m[v] = i
+			}
+		}
+		g.Adj[v] = g.Adj[last]
+		g.pos[v] = g.pos[last]
+	}
+	g.Adj[last] = nil
+	g.pos[last] = nil
+	g.Adj = g.Adj[:last]
+	g.pos = g.pos[:last]
+	g.V = last
+}
+
+// NumVertices returns the number of vertices in the graph. It and Adjacent have
+// value receivers, so that a Graph value satisfies Adjacency.
+func (g Graph) NumVertices() int {
+	return g.V
+}
+
+// Adjacent returns the vertices adjacent to v. The caller must not modify the
+// returned slice.
+func (g Graph) Adjacent(v int) []int {
+	g.validateVertex(v)
+	return g.Adj[v]
+}
+
+// Undirected marks Graph as satisfying Undirected.
+func (g Graph) Undirected() {}
+
 // Degree returns the degree of vertex v.
 func (g *Graph) Degree(v int) int {
 	g.validateVertex(v)
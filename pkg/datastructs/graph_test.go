@@ -17,6 +17,7 @@ package datastructs
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
 	"testing"
 )
@@ -129,6 +130,307 @@ func TestDegree(t *testing.T) {
 	}
 }
 
+// checkGraph verifies that g's adjacency lists agree with each other, with
+// g.E, and with the positions used for edge lookup.
+func checkGraph(t *testing.T, g Graph) {
+	t.Helper()
+	if len(g.Adj) != g.V || len(g.pos) > g.V {
+		t.Fatalf("expected %v lists; got %v and %v", g.V, len(g.Adj), len(g.pos))
+	}
+	total := 0
+	for v := 0; v < g.V; v++ {
+		total = total + len(g.Adj[v])
+		if v < len(g.pos) && g.pos[v] != nil {
+			if len(g.pos[v]) != len(g.Adj[v]) {
+				t.Errorf("vertex %v: expected %v positions; got %v", v, len(g.Adj[v]), len(g.pos[v]))
+			}
+			for i, w := range g.Adj[v] {
+				if g.pos[v][w] != i {
+					t.Errorf("vertex %v: expected %v at %v; got %v", v, w, i, g.pos[v][w])
+				}
+			}
+		}
+		for _, w := range g.Adj[v] {
+			if !g.HasEdge(w, v) {
+				t.Errorf("expected %v-%v to be an edge", w, v)
+			}
+		}
+	}
+	if total != 2*g.E {
+		t.Errorf("expected %v; got %v", 2*g.E, total)
+	}
+}
+
+func TestHasEdge(t *testing.T) {
+	g := CreateGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	testCases := []struct {
+		name string
+		v    int
+		w    int
+		has  bool
+	}{
+		{"t1", 0, 1, true},
+		{"t2", 1, 0, true},
+		{"t3", 2, 1, true},
+		{"t4", 0, 2, false},
+		{"t5", 3, 3, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if g.HasEdge(tc.v, tc.w) != tc.has {
+				t.Errorf("expected %v; got %v", tc.has, g.HasEdge(tc.v, tc.w))
+			}
+		})
+	}
+}
+
+func TestRemoveEdge(t *testing.T) {
+	g := CreateGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	g.AddEdge(0, 3)
+	g.AddEdge(0, 4)
+	g.AddEdge(1, 2)
+	g.RemoveEdge(1, 0)
+	g.RemoveEdge(3, 4) // not an edge, so this should be a noop
+
+	if g.E != 4 {
+		t.Errorf("expected %v; got %v", 4, g.E)
+	}
+	if g.HasEdge(0, 1) || g.HasEdge(1, 0) {
+		t.Errorf("expected %v; got %v", false, true)
+	}
+	// the rest of each list keeps its order
+	want := [][]int{{2, 3, 4}, {2}, {0, 1}, {0}, {0}}
+	if !reflect.DeepEqual(g.Adj, want) {
+		t.Errorf("expected %v; got %v", want, g.Adj)
+	}
+	checkGraph(t, g)
+
+	g.AddEdge(1, 0)
+	want = [][]int{{2, 3, 4, 1}, {2, 0}, {0, 1}, {0}, {0}}
+	if !reflect.DeepEqual(g.Adj, want) {
+		t.Errorf("expected %v; got %v", want, g.Adj)
+	}
+	checkGraph(t, g)
+}
+
+func TestRemoveEdgeKeepsOrder(t *testing.T) {
+	// vertex 0 has enough neighbours to be indexed, and keeps them in order as
+	// they're removed from the front, middle and back of its list
+	g := CreateGraph(20)
+	for w := 1; w < 20; w++ {
+		g.AddEdge(0, w)
+	}
+	want := []int{}
+	for w := 1; w < 20; w++ {
+		want = append(want, w)
+	}
+	remove := func(w int) {
+		g.RemoveEdge(0, w)
+		for i, x := range want {
+			if x == w {
+				want = append(want[:i], want[i+1:]...)
+				break
+			}
+		}
+	}
+	for _, w := range []int{1, 10, 19, 5, 6, 2, 18, 3, 4, 7, 8} {
+		remove(w)
+		if !reflect.DeepEqual(g.Adj[0], want) {
+			t.Errorf("expected %v; got %v", want, g.Adj[0])
+		}
+		checkGraph(t, g)
+	}
+	if !reflect.DeepEqual(g.Adj[0], []int{9, 11, 12, 13, 14, 15, 16, 17}) {
+		t.Errorf("expected %v; got %v", []int{9, 11, 12, 13, 14, 15, 16, 17}, g.Adj[0])
+	}
+}
+
+func TestGraphStructLiteral(t *testing.T) {
+	// a graph built without CreateGraph has no positions for edge lookup yet
+	g := Graph{V: 3, E: 1, Adj: [][]int{{1}, {0}, nil}}
+	if !g.HasEdge(1, 0) {
+		t.Errorf("expected %v; got %v", true, g.HasEdge(1, 0))
+	}
+	g.AddEdge(1, 2)
+	g.AddEdge(0, 1) // already an edge, so this should be a noop
+	v := g.AddVertex()
+	for w := 0; w < v; w++ {
+		g.AddEdge(v, w)
+	}
+	g.RemoveEdge(0, 1)
+	want := [][]int{{3}, {2, 3}, {1, 3}, {0, 1, 2}}
+	if !reflect.DeepEqual(g.Adj, want) {
+		t.Errorf("expected %v; got %v", want, g.Adj)
+	}
+	checkGraph(t, g)
+}
+
+func TestHasEdgeIsReadOnly(t *testing.T) {
+	// a vertex of high degree in a graph built as a struct literal
+	g := Graph{V: 12, E: 11, Adj: make([][]int, 12)}
+	for w := 1; w < g.V; w++ {
+		g.Adj[0] = append(g.Adj[0], w)
+		g.Adj[w] = []int{0}
+	}
+	if !g.HasEdge(0, 11) || g.HasEdge(1, 2) {
+		t.Errorf("expected %v and %v; got %v and %v", true, false, g.HasEdge(0, 11), g.HasEdge(1, 2))
+	}
+	// so concurrent queries don't race
+	if g.pos != nil {
+		t.Errorf("expected %v; got %v", nil, g.pos)
+	}
+	g.AddEdge(0, g.AddVertex())
+	if g.pos[0] == nil {
+		t.Errorf("expected vertex %v to be indexed", 0)
+	}
+	checkGraph(t, g)
+}
+
+func TestAddVertex(t *testing.T) {
+	g := CreateGraph(0)
+	for i := 0; i < 3; i++ {
+		if v := g.AddVertex(); v != i {
+			t.Errorf("expected %v; got %v", i, v)
+		}
+	}
+	g.AddEdge(0, 2)
+	if g.V != 3 || g.E != 1 {
+		t.Errorf("expected %v and %v; got %v and %v", 3, 1, g.V, g.E)
+	}
+	checkGraph(t, g)
+}
+
+func TestRemoveVertex(t *testing.T) {
+	g := CreateGraph(5)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(1, 4)
+	g.AddEdge(2, 4)
+	g.AddEdge(3, 4)
+	g.AddEdge(0, 3)
+	g.RemoveVertex(1)
+
+	// vertex 4 is renamed 1, so its neighbours 2 and 3 now see 1
+	if g.V != 4 || g.E != 3 {
+		t.Errorf("expected %v and %v; got %v and %v", 4, 3, g.V, g.E)
+	}
+	for _, w := range []int{2, 3} {
+		if !g.HasEdge(w, 1) {
+			t.Errorf("expected %v-%v to be an edge", w, 1)
+		}
+	}
+	want := [][]int{{3}, {2, 3}, {1}, {1, 0}}
+	if !reflect.DeepEqual(g.Adj, want) {
+		t.Errorf("expected %v; got %v", want, g.Adj)
+	}
+	checkGraph(t, g)
+
+	g.RemoveVertex(3) // the last vertex, so nothing is renamed
+	want = [][]int{{}, {2}, {1}}
+	if !reflect.DeepEqual(g.Adj, want) {
+		t.Errorf("expected %v; got %v", want, g.Adj)
+	}
+	checkGraph(t, g)
+}
+
+func TestGraphRandomMutations(t *testing.T) {
+	testCases := []struct {
+		name   string
+		add    int // relative frequency of AddEdge
+		remove int // relative frequency of RemoveEdge
+	}{
+		{"sparse", 5, 3},
+		{"dense", 30, 8},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			g := CreateGraph(10)
+			// names[v] = original name of vertex v, so the edges can be checked
+			// against a set that's unaffected by renaming
+			names := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+			next := 10
+			edges := map[[2]int]bool{}
+			key := func(v int, w int) [2]int {
+				a, b := names[v], names[w]
+				if a > b {
+					a, b = b, a
+				}
+				return [2]int{a, b}
+			}
+			indexed := false
+			for i := 0; i < 2000; i++ {
+				switch op := r.Intn(tc.add + tc.remove + 2); {
+				case op < tc.add && g.V > 1:
+					v, w := r.Intn(g.V), r.Intn(g.V)
+					if v != w {
+						g.AddEdge(v, w)
+						edges[key(v, w)] = true
+					}
+				case op < tc.add+tc.remove && g.V > 1:
+					v, w := r.Intn(g.V), r.Intn(g.V)
+					g.RemoveEdge(v, w)
+					delete(edges, key(v, w))
+				case op < tc.add+tc.remove+1 || g.V == 0:
+					g.AddVertex()
+					names = append(names, next)
+					next = next + 1
+				default:
+					v := r.Intn(g.V)
+					for k := range edges {
+						if k[0] == names[v] || k[1] == names[v] {
+							delete(edges, k)
+						}
+					}
+					g.RemoveVertex(v)
+					names[v] = names[len(names)-1]
+					names = names[:len(names)-1]
+				}
+				for _, m := range g.pos {
+					if m != nil {
+						indexed = true
+					}
+				}
+				if i%100 == 0 {
+					checkGraph(t, g)
+				}
+			}
+			// make sure both ways of looking up edges were exercised
+			if !indexed {
+				t.Errorf("expected some vertex to be indexed")
+			}
+			checkGraph(t, g)
+			if g.E != len(edges) {
+				t.Errorf("expected %v; got %v", len(edges), g.E)
+			}
+			for v := 0; v < g.V; v++ {
+				for w := 0; w < g.V; w++ {
+					if v != w && g.HasEdge(v, w) != edges[key(v, w)] {
+						t.Errorf("expected HasEdge(%v, %v) to be %v", v, w, edges[key(v, w)])
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGraphAdjacency(t *testing.T) {
+	g := CreateGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(0, 2)
+	var a Adjacency = g
+	if a.NumVertices() != 3 {
+		t.Errorf("expected %v; got %v", 3, a.NumVertices())
+	}
+	if !reflect.DeepEqual(a.Adjacent(0), []int{1, 2}) {
+		t.Errorf("expected %v; got %v", []int{1, 2}, a.Adjacent(0))
+	}
+}
+
 func ExampleGraph() {
 	g := CreateGraph(11)
 	g.AddEdge(1, 9)
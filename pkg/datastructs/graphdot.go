@@ -0,0 +1,167 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// DOTOptions controls how the Write...DOT functions render a graph.
+type DOTOptions struct {
+	// Name is the name of the graph in the output. If empty, it's "G".
+	Name string
+	// HighlightVertices lists vertices to draw in the highlight color, such as
+	// the vertices reached by a search.
+	HighlightVertices []int
+	// HighlightEdges lists edges to draw in the highlight color, such as the
+	// edges of a BFS tree or an MST, given by their endpoints. In an undirected
+	// graph, either order matches; in a graph with parallel edges, all of the
+	// edges between the two vertices are highlighted. Use PathEdges to
+	// highlight a path given as a sequence of vertices.
+	HighlightEdges [][2]int
+	// HighlightColor is the Graphviz color used for highlighting. If empty, it's
+	// "red".
+	HighlightColor string
+}
+
+// PathEdges returns the edges between consecutive vertices of path, for use as
+// DOTOptions.HighlightEdges.
+func PathEdges(path []int) [][2]int {
+	var edges [][2]int
+	for i := 0; i+1 < len(path); i++ {
+		edges = append(edges, [2]int{path[i], path[i+1]})
+	}
+	return edges
+}
+
+// dotWriter writes the parts of a DOT graph that are the same for every kind
+// of graph.
+type dotWriter struct {
+	bw       *bufio.Writer
+	directed bool
+	color    string
+	vertices map[int]bool
+	edges    map[[2]int]bool
+}
+
+func newDOTWriter(w io.Writer, v int, directed bool, opts *DOTOptions) *dotWriter {
+	if opts == nil {
+		opts = &DOTOptions{}
+	}
+	d := &dotWriter{bw: bufio.NewWriter(w), directed: directed, color: opts.HighlightColor,
+		vertices: map[int]bool{}, edges: map[[2]int]bool{}}
+	if d.color == "" {
+		d.color = "red"
+	}
+	for _, x := range opts.HighlightVertices {
+		d.vertices[x] = true
+	}
+	for _, e := range opts.HighlightEdges {
+		d.edges[e] = true
+	}
+	name, kind := opts.Name, "graph"
+	if name == "" {
+		name = "G"
+	}
+	if directed {
+		kind = "digraph"
+	}
+	fmt.Fprintf(d.bw, "%v %q {\n", kind, name)
+	// list every vertex, so that isolated ones are drawn too
+	for x := 0; x < v; x++ {
+		if d.vertices[x] {
+			fmt.Fprintf(d.bw, "  %v [color=%q, penwidth=2];\n", x, d.color)
+		} else {
+			fmt.Fprintf(d.bw, "  %v;\n", x)
+		}
+	}
+	return d
+}
+
+// edge writes the edge v-w, or v->w in a digraph, with an optional label.
+func (d *dotWriter) edge(v int, w int, label string) {
+	op := "--"
+	highlight := d.edges[[2]int{v, w}]
+	if d.directed {
+		op = "->"
+	} else {
+		highlight = highlight || d.edges[[2]int{w, v}]
+	}
+	var attrs []string
+	if label != "" {
+		attrs = append(attrs, fmt.Sprintf("label=%q", label))
+	}
+	if highlight {
+		attrs = append(attrs, fmt.Sprintf("color=%q", d.color), "penwidth=2")
+	}
+	if len(attrs) == 0 {
+		fmt.Fprintf(d.bw, "  %v %v %v;\n", v, op, w)
+	} else {
+		fmt.Fprintf(d.bw, "  %v %v %v [%v];\n", v, op, w, strings.Join(attrs, ", "))
+	}
+}
+
+func (d *dotWriter) close() error {
+	fmt.Fprint(d.bw, "}\n")
+	return d.bw.Flush()
+}
+
+// WriteGraphDOT writes g to w in the Graphviz DOT language. opts may be nil.
+func WriteGraphDOT(w io.Writer, g Graph, opts *DOTOptions) error {
+	d := newDOTWriter(w, g.V, false, opts)
+	for v := 0; v < g.V; v++ {
+		for _, x := range g.Adj[v] {
+			if v < x {
+				d.edge(v, x, "")
+			}
+		}
+	}
+	return d.close()
+}
+
+// WriteDigraphDOT writes g to w in the Graphviz DOT language. opts may be nil.
+func WriteDigraphDOT(w io.Writer, g Digraph, opts *DOTOptions) error {
+	d := newDOTWriter(w, g.V, true, opts)
+	for v := 0; v < g.V; v++ {
+		for _, x := range g.Adj[v] {
+			d.edge(v, x, "")
+		}
+	}
+	return d.close()
+}
+
+// WriteEdgeWeightedGraphDOT writes g to w in the Graphviz DOT language, with
+// each edge labeled with its weight. opts may be nil.
+func WriteEdgeWeightedGraphDOT(w io.Writer, g EdgeWeightedGraph, opts *DOTOptions) error {
+	d := newDOTWriter(w, g.V, false, opts)
+	for _, e := range g.Edges() {
+		d.edge(e.V, e.W, formatWeight(e.Weight))
+	}
+	return d.close()
+}
+
+// WriteEdgeWeightedDigraphDOT writes g to w in the Graphviz DOT language, with
+// each edge labeled with its weight. opts may be nil.
+func WriteEdgeWeightedDigraphDOT(w io.Writer, g EdgeWeightedDigraph, opts *DOTOptions) error {
+	d := newDOTWriter(w, g.V, true, opts)
+	for _, e := range g.Edges() {
+		d.edge(e.From, e.To, formatWeight(e.Weight))
+	}
+	return d.close()
+}
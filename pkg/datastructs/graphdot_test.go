@@ -0,0 +1,124 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWriteGraphDOTHighlight(t *testing.T) {
+	g := CreateGraph(4)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 3)
+	g.AddEdge(3, 0)
+	var sb strings.Builder
+	// the path 3-2-1 is given against the order the edges are written in
+	opts := &DOTOptions{Name: "path", HighlightVertices: []int{1, 3}, HighlightEdges: PathEdges([]int{3, 2, 1}), HighlightColor: "blue"}
+	if err := WriteGraphDOT(&sb, g, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := `graph "path" {
+  0;
+  1 [color="blue", penwidth=2];
+  2;
+  3 [color="blue", penwidth=2];
+  0 -- 1;
+  0 -- 3;
+  1 -- 2 [color="blue", penwidth=2];
+  2 -- 3 [color="blue", penwidth=2];
+}
+`
+	if sb.String() != want {
+		t.Errorf("expected %v; got %v", want, sb.String())
+	}
+}
+
+func TestWriteDigraphDOTHighlight(t *testing.T) {
+	g := CreateDigraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 0)
+	g.AddEdge(2, 2)
+	var sb strings.Builder
+	// in a digraph, only the edge in the given direction is highlighted
+	if err := WriteDigraphDOT(&sb, g, &DOTOptions{HighlightEdges: [][2]int{{1, 0}}}); err != nil {
+		t.Fatal(err)
+	}
+	want := `digraph "G" {
+  0;
+  1;
+  2;
+  0 -> 1;
+  1 -> 0 [color="red", penwidth=2];
+  2 -> 2;
+}
+`
+	if sb.String() != want {
+		t.Errorf("expected %v; got %v", want, sb.String())
+	}
+}
+
+func TestPathEdges(t *testing.T) {
+	testCases := []struct {
+		name  string
+		path  []int
+		edges [][2]int
+	}{
+		{"t1", nil, nil},
+		{"t2", []int{4}, nil},
+		{"t3", []int{4, 2, 7}, [][2]int{{4, 2}, {2, 7}}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := PathEdges(tc.path); !reflect.DeepEqual(got, tc.edges) {
+				t.Errorf("expected %v; got %v", tc.edges, got)
+			}
+		})
+	}
+}
+
+func ExampleWriteEdgeWeightedGraphDOT() {
+	g := CreateEdgeWeightedGraph(3)
+	g.AddEdge(Edge{0, 1, 0.5})
+	g.AddEdge(Edge{1, 2, 0.25})
+	g.AddEdge(Edge{0, 2, 1.5})
+	// highlight the MST
+	WriteEdgeWeightedGraphDOT(os.Stdout, g, &DOTOptions{Name: "mst", HighlightEdges: [][2]int{{0, 1}, {1, 2}}})
+	// Output:
+	// graph "mst" {
+	//   0;
+	//   1;
+	//   2;
+	//   0 -- 1 [label="0.5", color="red", penwidth=2];
+	//   0 -- 2 [label="1.5"];
+	//   1 -- 2 [label="0.25", color="red", penwidth=2];
+	// }
+}
+
+func ExampleWriteEdgeWeightedDigraphDOT() {
+	g := CreateEdgeWeightedDigraph(2)
+	g.AddEdge(DirectedEdge{0, 1, 0.5})
+	WriteEdgeWeightedDigraphDOT(os.Stdout, g, nil)
+	// Output:
+	// digraph "G" {
+	//   0;
+	//   1;
+	//   0 -> 1 [label="0.5"];
+	// }
+}
@@ -0,0 +1,291 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// GraphGenerator generates random and structured graphs. All of its randomness
+// comes from the *rand.Rand it's created with, so a generator seeded the same
+// way always produces the same graphs.
+//
+// The structured graphs have fixed vertex names, documented on each method,
+// so that tests can refer to particular vertices.
+type GraphGenerator struct {
+	r *rand.Rand
+}
+
+// NewGraphGenerator returns a generator that draws random numbers from r.
+func NewGraphGenerator(r *rand.Rand) *GraphGenerator {
+	if r == nil {
+		panic("r must not be nil")
+	}
+	return &GraphGenerator{r: r}
+}
+
+func checkNonNegative(name string, n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("%v must be non-negative; got %v", name, n))
+	}
+}
+
+// ErdosRenyi returns a random graph on v vertices in which each of the
+// possible edges is present independently with probability p.
+func (gen *GraphGenerator) ErdosRenyi(v int, p float64) Graph {
+	if p < 0 || p > 1 {
+		panic(fmt.Sprintf("probability %v is not between 0 and 1", p))
+	}
+	g := CreateGraph(v)
+	for x := 0; x < v; x++ {
+		for y := x + 1; y < v; y++ {
+			if gen.r.Float64() < p {
+				g.AddEdge(x, y)
+			}
+		}
+	}
+	return g
+}
+
+// Simple returns a random simple graph with v vertices and exactly e edges,
+// chosen uniformly from all such graphs. It panics if e is more than the
+// v(v – 1)/2 edges a simple graph can have.
+func (gen *GraphGenerator) Simple(v int, e int) Graph {
+	checkNonNegative("number of edges", e)
+	if e > v*(v-1)/2 {
+		panic(fmt.Sprintf("too many edges: %v vertices can have at most %v", v, v*(v-1)/2))
+	}
+	g := CreateGraph(v)
+	for g.E < e {
+		x, y := gen.r.Intn(v), gen.r.Intn(v)
+		if x != y {
+			g.AddEdge(x, y)
+		}
+	}
+	return g
+}
+
+// Bipartite returns a random bipartite graph with exactly e edges, each between
+// one of the v1 vertices 0 through v1 – 1 and one of the v2 vertices v1 through
+// v1 + v2 – 1. It panics if e is more than v1·v2.
+func (gen *GraphGenerator) Bipartite(v1 int, v2 int, e int) Graph {
+	checkNonNegative("v1", v1)
+	checkNonNegative("v2", v2)
+	checkNonNegative("number of edges", e)
+	if e > v1*v2 {
+		panic(fmt.Sprintf("too many edges: %v and %v vertices can have at most %v", v1, v2, v1*v2))
+	}
+	g := CreateGraph(v1 + v2)
+	for g.E < e {
+		g.AddEdge(gen.r.Intn(v1), v1+gen.r.Intn(v2))
+	}
+	return g
+}
+
+// CompleteBipartite returns the complete bipartite graph with an edge between
+// each of the v1 vertices 0 through v1 – 1 and each of the v2 vertices v1
+// through v1 + v2 – 1.
+func (gen *GraphGenerator) CompleteBipartite(v1 int, v2 int) Graph {
+	return gen.Bipartite(v1, v2, v1*v2)
+}
+
+// Complete returns the complete graph on v vertices.
+func (gen *GraphGenerator) Complete(v int) Graph {
+	g := CreateGraph(v)
+	for x := 0; x < v; x++ {
+		for y := x + 1; y < v; y++ {
+			g.AddEdge(x, y)
+		}
+	}
+	return g
+}
+
+// Path returns the path 0-1-2-...-(v – 1).
+func (gen *GraphGenerator) Path(v int) Graph {
+	g := CreateGraph(v)
+	for x := 0; x+1 < v; x++ {
+		g.AddEdge(x, x+1)
+	}
+	return g
+}
+
+// Cycle returns the cycle 0-1-2-...-(v – 1)-0. It panics if v is less than 3.
+func (gen *GraphGenerator) Cycle(v int) Graph {
+	if v < 3 {
+		panic(fmt.Sprintf("a cycle needs at least 3 vertices; got %v", v))
+	}
+	g := gen.Path(v)
+	g.AddEdge(v-1, 0)
+	return g
+}
+
+// Star returns the star with center 0 and v – 1 leaves, 1 through v – 1.
+func (gen *GraphGenerator) Star(v int) Graph {
+	g := CreateGraph(v)
+	for x := 1; x < v; x++ {
+		g.AddEdge(0, x)
+	}
+	return g
+}
+
+// Wheel returns the wheel with hub 0 joined to each vertex of the cycle
+// 1-2-...-(v – 1)-1. It panics if v is less than 4.
+func (gen *GraphGenerator) Wheel(v int) Graph {
+	if v < 4 {
+		panic(fmt.Sprintf("a wheel needs at least 4 vertices; got %v", v))
+	}
+	g := gen.Star(v)
+	for x := 1; x < v; x++ {
+		g.AddEdge(x, x%(v-1)+1)
+	}
+	return g
+}
+
+// Grid returns the rows-by-cols grid graph, in which the vertex in row r and
+// column c is r·cols + c, and is joined to the vertices above, below, left and
+// right of it.
+func (gen *GraphGenerator) Grid(rows int, cols int) Graph {
+	checkNonNegative("rows", rows)
+	checkNonNegative("cols", cols)
+	g := CreateGraph(rows * cols)
+	for r := 0; r < rows; r++ {
+		for c := 0; c < cols; c++ {
+			v := r*cols + c
+			if c+1 < cols {
+				g.AddEdge(v, v+1)
+			}
+			if r+1 < rows {
+				g.AddEdge(v, v+cols)
+			}
+		}
+	}
+	return g
+}
+
+// Regular returns a random k-regular graph on v vertices: one in which every
+// vertex has degree k. It starts from a circulant graph, joining each vertex to
+// the k/2 vertices on either side of it (and, if k is odd, to the opposite
+// vertex), then randomizes it with degree-preserving edge swaps. The result
+// isn't exactly uniform over k-regular graphs, but it's close. It panics if
+// k ≥ v or if v·k is odd, since there is then no such graph.
+func (gen *GraphGenerator) Regular(v int, k int) Graph {
+	checkNonNegative("degree", k)
+	if k >= v && v > 0 || v*k%2 != 0 {
+		panic(fmt.Sprintf("there is no %v-regular graph on %v vertices", k, v))
+	}
+	g := CreateGraph(v)
+	var edges [][2]int
+	add := func(x int, y int) {
+		g.AddEdge(x, y)
+		edges = append(edges, [2]int{x, y})
+	}
+	for x := 0; x < v; x++ {
+		for d := 1; d <= k/2; d++ {
+			add(x, (x+d)%v)
+		}
+		if k%2 == 1 && x < v/2 {
+			add(x, x+v/2)
+		}
+	}
+
+	// swap a-b and c-d for a-d and c-b, as long as that doesn't create a self
+	// loop or a parallel edge
+	for i := 0; i < 10*len(edges); i++ {
+		p, q := gen.r.Intn(len(edges)), gen.r.Intn(len(edges))
+		a, b := edges[p][0], edges[p][1]
+		c, d := edges[q][0], edges[q][1]
+		if gen.r.Intn(2) == 0 {
+			c, d = d, c
+		}
+		if a == d || c == b || g.HasEdge(a, d) || g.HasEdge(c, b) {
+			continue
+		}
+		g.RemoveEdge(a, b)
+		g.RemoveEdge(c, d)
+		g.AddEdge(a, d)
+		g.AddEdge(c, b)
+		edges[p] = [2]int{a, d}
+		edges[q] = [2]int{c, b}
+	}
+	return g
+}
+
+// Tree returns a random tree on v vertices, chosen uniformly from all labeled
+// trees by decoding a random Prüfer sequence.
+func (gen *GraphGenerator) Tree(v int) Graph {
+	g := CreateGraph(v)
+	if v <= 1 {
+		return g
+	}
+	// each vertex appears in the Prüfer sequence one fewer times than its degree
+	prufer := make([]int, v-2)
+	degree := make([]int, v)
+	for i := range prufer {
+		prufer[i] = gen.r.Intn(v)
+		degree[prufer[i]] = degree[prufer[i]] + 1
+	}
+	leaves := NewMinPQ[int](func(a int, b int) bool { return a < b })
+	for x := 0; x < v; x++ {
+		degree[x] = degree[x] + 1
+		if degree[x] == 1 {
+			leaves.Insert(x)
+		}
+	}
+	// join the smallest leaf to the next vertex in the sequence, which becomes
+	// a leaf itself once it's been used up
+	for _, x := range prufer {
+		leaf := leaves.DelMin()
+		g.AddEdge(leaf, x)
+		degree[x] = degree[x] - 1
+		if degree[x] == 1 {
+			leaves.Insert(x)
+		}
+	}
+	g.AddEdge(leaves.DelMin(), leaves.DelMin())
+	return g
+}
+
+// PreferentialAttachment returns a random graph on v vertices built by the
+// Barabási–Albert model. It starts with a complete graph on the first m + 1
+// vertices, then adds the rest one at a time, joining each to m distinct
+// earlier vertices chosen with probability proportional to their degree. This
+// gives a scale-free graph, with a few high-degree hubs. It panics if m is
+// less than 1.
+func (gen *GraphGenerator) PreferentialAttachment(v int, m int) Graph {
+	if m < 1 {
+		panic(fmt.Sprintf("m must be at least 1; got %v", m))
+	}
+	g := CreateGraph(v)
+	// ends holds both endpoints of every edge, so each vertex appears in it as
+	// many times as its degree
+	var ends []int
+	for x := 0; x <= m && x < v; x++ {
+		for y := 0; y < x; y++ {
+			g.AddEdge(y, x)
+			ends = append(ends, y, x)
+		}
+	}
+	for x := m + 1; x < v; x++ {
+		for g.Degree(x) < m {
+			g.AddEdge(x, ends[gen.r.Intn(len(ends))])
+		}
+		for _, y := range g.Adj[x] {
+			ends = append(ends, x, y)
+		}
+	}
+	return g
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// components returns the number of connected components of g.
+func components(g Graph) int {
+	uf := NewUnionFind(g.V)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			uf.Union(v, w)
+		}
+	}
+	return uf.Count()
+}
+
+func newTestGenerator() *GraphGenerator {
+	return NewGraphGenerator(rand.New(rand.NewSource(1)))
+}
+
+func TestGraphGeneratorReproducible(t *testing.T) {
+	a, b := newTestGenerator(), newTestGenerator()
+	testCases := []struct {
+		name string
+		gen  func(*GraphGenerator) Graph
+	}{
+		{"ErdosRenyi", func(gen *GraphGenerator) Graph { return gen.ErdosRenyi(30, 0.2) }},
+		{"Simple", func(gen *GraphGenerator) Graph { return gen.Simple(30, 50) }},
+		{"Bipartite", func(gen *GraphGenerator) Graph { return gen.Bipartite(10, 20, 50) }},
+		{"Regular", func(gen *GraphGenerator) Graph { return gen.Regular(30, 3) }},
+		{"Tree", func(gen *GraphGenerator) Graph { return gen.Tree(30) }},
+		{"PreferentialAttachment", func(gen *GraphGenerator) Graph { return gen.PreferentialAttachment(30, 2) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, h := tc.gen(a), tc.gen(b)
+			if !reflect.DeepEqual(g.Adj, h.Adj) {
+				t.Errorf("expected %v; got %v", g.String(), h.String())
+			}
+		})
+	}
+}
+
+func TestGraphGeneratorCounts(t *testing.T) {
+	gen := newTestGenerator()
+	testCases := []struct {
+		name       string
+		g          Graph
+		v          int
+		e          int
+		components int
+	}{
+		{"Simple", gen.Simple(20, 40), 20, 40, -1},
+		{"Simple complete", gen.Simple(6, 15), 6, 15, 1},
+		{"Bipartite", gen.Bipartite(5, 8, 30), 13, 30, -1},
+		{"CompleteBipartite", gen.CompleteBipartite(3, 4), 7, 12, 1},
+		{"Complete", gen.Complete(7), 7, 21, 1},
+		{"Path", gen.Path(5), 5, 4, 1},
+		{"Path of one", gen.Path(1), 1, 0, 1},
+		{"Cycle", gen.Cycle(5), 5, 5, 1},
+		{"Star", gen.Star(6), 6, 5, 1},
+		{"Wheel", gen.Wheel(6), 6, 10, 1},
+		{"Grid", gen.Grid(3, 4), 12, 17, 1},
+		{"Regular", gen.Regular(20, 3), 20, 30, -1},
+		{"Regular even", gen.Regular(9, 4), 9, 18, -1},
+		{"Tree", gen.Tree(50), 50, 49, 1},
+		{"Tree of two", gen.Tree(2), 2, 1, 1},
+		{"PreferentialAttachment", gen.PreferentialAttachment(50, 3), 50, 6 + 46*3, 1},
+		{"ErdosRenyi empty", gen.ErdosRenyi(10, 0), 10, 0, 10},
+		{"ErdosRenyi complete", gen.ErdosRenyi(10, 1), 10, 45, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.g.V != tc.v || tc.g.E != tc.e {
+				t.Errorf("expected %v and %v; got %v and %v", tc.v, tc.e, tc.g.V, tc.g.E)
+			}
+			if tc.components != -1 && components(tc.g) != tc.components {
+				t.Errorf("expected %v; got %v", tc.components, components(tc.g))
+			}
+		})
+	}
+}
+
+func TestGraphGeneratorBipartiteSides(t *testing.T) {
+	g := newTestGenerator().Bipartite(6, 9, 40)
+	for v := 0; v < g.V; v++ {
+		for _, w := range g.Adj[v] {
+			if (v < 6) == (w < 6) {
+				t.Errorf("edge %v-%v is within one side", v, w)
+			}
+		}
+	}
+}
+
+func TestGraphGeneratorRegular(t *testing.T) {
+	gen := newTestGenerator()
+	for _, vk := range [][2]int{{10, 0}, {10, 1}, {10, 3}, {11, 4}, {12, 11}, {40, 5}} {
+		g := gen.Regular(vk[0], vk[1])
+		for v := 0; v < g.V; v++ {
+			if g.Degree(v) != vk[1] {
+				t.Errorf("expected %v; got %v", vk[1], g.Degree(v))
+			}
+		}
+	}
+	// the swaps should have moved the graph away from the circulant one
+	g := gen.Regular(40, 4)
+	if reflect.DeepEqual(g.Adj, gen.Regular(40, 4).Adj) {
+		t.Errorf("expected two random 4-regular graphs to differ")
+	}
+}
+
+func TestGraphGeneratorPreferentialAttachment(t *testing.T) {
+	g := newTestGenerator().PreferentialAttachment(2000, 2)
+	max := 0
+	for v := 0; v < g.V; v++ {
+		if g.Degree(v) < 2 {
+			t.Errorf("expected a degree of at least %v; got %v", 2, g.Degree(v))
+		}
+		if g.Degree(v) > max {
+			max = g.Degree(v)
+		}
+	}
+	// the average degree is about 4, but a scale-free graph has hubs
+	if max < 40 {
+		t.Errorf("expected a hub with degree at least %v; got %v", 40, max)
+	}
+}
+
+func TestGraphGeneratorPanics(t *testing.T) {
+	gen := newTestGenerator()
+	testCases := []struct {
+		name string
+		f    func()
+	}{
+		{"nil rand", func() { NewGraphGenerator(nil) }},
+		{"probability", func() { gen.ErdosRenyi(5, 1.5) }},
+		{"too many edges", func() { gen.Simple(4, 7) }},
+		{"too many bipartite edges", func() { gen.Bipartite(2, 3, 7) }},
+		{"short cycle", func() { gen.Cycle(2) }},
+		{"small wheel", func() { gen.Wheel(3) }},
+		{"odd regular", func() { gen.Regular(5, 3) }},
+		{"dense regular", func() { gen.Regular(5, 5) }},
+		{"attachment", func() { gen.PreferentialAttachment(5, 0) }},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("expected a panic")
+				}
+			}()
+			tc.f()
+		})
+	}
+}
+
+func ExampleGraphGenerator() {
+	gen := NewGraphGenerator(rand.New(rand.NewSource(42)))
+	g := gen.Wheel(5)
+	fmt.Print(g.String())
+	// Output:
+	// 5 vertices; 8 edges
+	// 0: 1 2 3 4
+	// 1: 0 2 4
+	// 2: 0 1 3
+	// 3: 0 2 4
+	// 4: 0 3 1
+}
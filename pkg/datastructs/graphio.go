@@ -0,0 +1,353 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// The functions in this file read and write graphs in the text format used by
+// the algs4 data files, such as tinyG.txt: the number of vertices V, then the
+// number of edges E, then E edges, each given as two vertices and, for weighted
+// graphs, a weight. Tokens are separated by any whitespace, including newlines.
+
+// MaxReadVertices is the largest number of vertices that the Read functions
+// accept. The graph is allocated as soon as the header is read, so the limit
+// turns a malformed or hostile header into an error rather than an allocation
+// that exhausts memory. The default is far above the size of the largest algs4
+// files, such as largeG.txt; programs that read bigger graphs can raise it.
+// The number of edges isn't limited, since nothing is allocated for the edges
+// until they've been read.
+var MaxReadVertices = 1 << 24
+
+// maxLineLength is the longest line that the Read functions and NewSymbolGraph
+// accept.
+const maxLineLength = 16 << 20
+
+// ParseError is the error returned when the input to one of the Read functions
+// is malformed.
+type ParseError struct {
+	Line int   // 1-based line number of the malformed input
+	Err  error // what's wrong with it
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %v: %v", e.Line, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// tokenReader splits its input into whitespace-separated tokens and keeps
+// track of the line each one is on.
+type tokenReader struct {
+	sc     *bufio.Scanner
+	line   int      // line number of the last token returned
+	fields []string // tokens left on the current line
+}
+
+func newTokenReader(r io.Reader) *tokenReader {
+	return &tokenReader{sc: newLineScanner(r)}
+}
+
+// newLineScanner returns a scanner that splits r into lines of up to
+// maxLineLength bytes, rather than the bufio default of 64KB.
+func newLineScanner(r io.Reader) *bufio.Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineLength)
+	return sc
+}
+
+func (r *tokenReader) errorf(format string, a ...any) error {
+	return &ParseError{Line: r.line, Err: fmt.Errorf(format, a...)}
+}
+
+// next returns the next token. At the end of the input, it returns a
+// *ParseError wrapping io.ErrUnexpectedEOF, with the line number of the last
+// token.
+func (r *tokenReader) next(what string) (string, error) {
+	for len(r.fields) == 0 {
+		if !r.sc.Scan() {
+			if err := r.sc.Err(); err != nil {
+				// the error is in the line after the last one scanned
+				return "", &ParseError{Line: r.line + 1, Err: err}
+			}
+			return "", &ParseError{Line: r.line, Err: fmt.Errorf("missing %v: %w", what, io.ErrUnexpectedEOF)}
+		}
+		r.line = r.line + 1
+		r.fields = strings.Fields(r.sc.Text())
+	}
+	token := r.fields[0]
+	r.fields = r.fields[1:]
+	return token, nil
+}
+
+// nextInt returns the next token as a non-negative int. It's used for
+// counts and vertices, which can't be negative.
+func (r *tokenReader) nextInt(what string) (int, error) {
+	token, err := r.next(what)
+	if err != nil {
+		return 0, err
+	}
+	n, err := strconv.Atoi(token)
+	if err != nil || n < 0 {
+		return 0, r.errorf("invalid %v %q", what, token)
+	}
+	return n, nil
+}
+
+// nextVertex returns the next token as a vertex between 0 and v – 1.
+func (r *tokenReader) nextVertex(v int) (int, error) {
+	w, err := r.nextInt("vertex")
+	if err != nil {
+		return 0, err
+	}
+	if w >= v {
+		return 0, r.errorf("vertex %v is not between 0 and %v", w, v-1)
+	}
+	return w, nil
+}
+
+// nextWeight returns the next token as an edge weight.
+func (r *tokenReader) nextWeight() (float64, error) {
+	token, err := r.next("weight")
+	if err != nil {
+		return 0, err
+	}
+	w, err := strconv.ParseFloat(token, 64)
+	if err != nil || math.IsNaN(w) {
+		return 0, r.errorf("invalid weight %q", token)
+	}
+	return w, nil
+}
+
+// readHeader reads the number of vertices and edges, and checks that the
+// number of vertices is no more than MaxReadVertices.
+func (r *tokenReader) readHeader() (int, int, error) {
+	v, err := r.nextInt("number of vertices")
+	if err != nil {
+		return 0, 0, err
+	}
+	if v > MaxReadVertices {
+		return 0, 0, r.errorf("number of vertices %v is more than MaxReadVertices (%v)", v, MaxReadVertices)
+	}
+	e, err := r.nextInt("number of edges")
+	if err != nil {
+		return 0, 0, err
+	}
+	return v, e, nil
+}
+
+// readEdges calls add for each of the e edges in the input, and then checks
+// that there's nothing left but whitespace. If add returns an error, it's
+// reported at the line of the edge.
+func (r *tokenReader) readEdges(e int, add func() error) error {
+	for i := 0; i < e; i++ {
+		if err := add(); err != nil {
+			var pe *ParseError
+			if errors.As(err, &pe) {
+				return err
+			}
+			return &ParseError{Line: r.line, Err: err}
+		}
+	}
+	if token, err := r.next("end of input"); err == nil {
+		return r.errorf("unexpected %q after %v edges", token, e)
+	} else if !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	return nil
+}
+
+// readPair reads the two vertices of an edge.
+func (r *tokenReader) readPair(v int) (int, int, error) {
+	a, err := r.nextVertex(v)
+	if err != nil {
+		return 0, 0, err
+	}
+	b, err := r.nextVertex(v)
+	if err != nil {
+		return 0, 0, err
+	}
+	return a, b, nil
+}
+
+// ReadGraph reads an undirected graph from r. Since Graph disallows self loops,
+// an edge from a vertex to itself is an error. A repeated edge is added only
+// once, so the graph may have fewer edges than the input says.
+func ReadGraph(r io.Reader) (Graph, error) {
+	tr := newTokenReader(r)
+	v, e, err := tr.readHeader()
+	if err != nil {
+		return Graph{}, err
+	}
+	g := CreateGraph(v)
+	err = tr.readEdges(e, func() error {
+		a, b, err := tr.readPair(v)
+		if err != nil {
+			return err
+		}
+		if a == b {
+			return fmt.Errorf("self loop %v-%v is not allowed", a, b)
+		}
+		g.AddEdge(a, b)
+		return nil
+	})
+	if err != nil {
+		return Graph{}, err
+	}
+	return g, nil
+}
+
+// ReadDigraph reads a directed graph from r. A repeated edge is added only
+// once, so the digraph may have fewer edges than the input says.
+func ReadDigraph(r io.Reader) (Digraph, error) {
+	tr := newTokenReader(r)
+	v, e, err := tr.readHeader()
+	if err != nil {
+		return Digraph{}, err
+	}
+	g := CreateDigraph(v)
+	err = tr.readEdges(e, func() error {
+		a, b, err := tr.readPair(v)
+		if err != nil {
+			return err
+		}
+		g.AddEdge(a, b)
+		return nil
+	})
+	if err != nil {
+		return Digraph{}, err
+	}
+	return g, nil
+}
+
+// ReadEdgeWeightedGraph reads an edge-weighted graph from r. Since
+// EdgeWeightedGraph disallows self loops, an edge from a vertex to itself is an
+// error.
+func ReadEdgeWeightedGraph(r io.Reader) (EdgeWeightedGraph, error) {
+	tr := newTokenReader(r)
+	v, e, err := tr.readHeader()
+	if err != nil {
+		return EdgeWeightedGraph{}, err
+	}
+	g := CreateEdgeWeightedGraph(v)
+	err = tr.readEdges(e, func() error {
+		a, b, err := tr.readPair(v)
+		if err != nil {
+			return err
+		}
+		weight, err := tr.nextWeight()
+		if err != nil {
+			return err
+		}
+		if a == b {
+			return fmt.Errorf("self loop %v-%v is not allowed", a, b)
+		}
+		g.AddEdge(Edge{V: a, W: b, Weight: weight})
+		return nil
+	})
+	if err != nil {
+		return EdgeWeightedGraph{}, err
+	}
+	return g, nil
+}
+
+// ReadEdgeWeightedDigraph reads an edge-weighted digraph from r.
+func ReadEdgeWeightedDigraph(r io.Reader) (EdgeWeightedDigraph, error) {
+	tr := newTokenReader(r)
+	v, e, err := tr.readHeader()
+	if err != nil {
+		return EdgeWeightedDigraph{}, err
+	}
+	g := CreateEdgeWeightedDigraph(v)
+	err = tr.readEdges(e, func() error {
+		a, b, err := tr.readPair(v)
+		if err != nil {
+			return err
+		}
+		weight, err := tr.nextWeight()
+		if err != nil {
+			return err
+		}
+		g.AddEdge(DirectedEdge{From: a, To: b, Weight: weight})
+		return nil
+	})
+	if err != nil {
+		return EdgeWeightedDigraph{}, err
+	}
+	return g, nil
+}
+
+// formatWeight formats an edge weight with as many digits as it takes to read
+// it back exactly.
+func formatWeight(w float64) string {
+	return strconv.FormatFloat(w, 'g', -1, 64)
+}
+
+// WriteGraph writes g to w, listing each edge once, with its lower-numbered
+// vertex first.
+func WriteGraph(w io.Writer, g Graph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%v\n%v\n", g.V, g.E)
+	for v := 0; v < g.V; v++ {
+		for _, x := range g.Adj[v] {
+			if v < x {
+				fmt.Fprintf(bw, "%v %v\n", v, x)
+			}
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteDigraph writes g to w.
+func WriteDigraph(w io.Writer, g Digraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%v\n%v\n", g.V, g.E)
+	for v := 0; v < g.V; v++ {
+		for _, x := range g.Adj[v] {
+			fmt.Fprintf(bw, "%v %v\n", v, x)
+		}
+	}
+	return bw.Flush()
+}
+
+// WriteEdgeWeightedGraph writes g to w, listing each edge once.
+func WriteEdgeWeightedGraph(w io.Writer, g EdgeWeightedGraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%v\n%v\n", g.V, g.E)
+	for _, e := range g.Edges() {
+		fmt.Fprintf(bw, "%v %v %v\n", e.V, e.W, formatWeight(e.Weight))
+	}
+	return bw.Flush()
+}
+
+// WriteEdgeWeightedDigraph writes g to w.
+func WriteEdgeWeightedDigraph(w io.Writer, g EdgeWeightedDigraph) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "%v\n%v\n", g.V, g.E)
+	for _, e := range g.Edges() {
+		fmt.Fprintf(bw, "%v %v %v\n", e.From, e.To, formatWeight(e.Weight))
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,291 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func openTestData(t *testing.T, name string) *os.File {
+	t.Helper()
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { f.Close() })
+	return f
+}
+
+func TestReadGraph(t *testing.T) {
+	g, err := ReadGraph(openTestData(t, "tinyG.txt"))
+	if err != nil {
+		t.Fatalf("expected %v; got %v", nil, err)
+	}
+	if g.V != 13 || g.E != 13 {
+		t.Errorf("expected %v and %v; got %v and %v", 13, 13, g.V, g.E)
+	}
+	want := [][]int{{5, 1, 2, 6}, {0}, {0}, {4, 5}, {3, 6, 5}, {0, 4, 3}, {4, 0}, {8}, {7}, {12, 10, 11}, {9}, {12, 9}, {9, 11}}
+	if !reflect.DeepEqual(g.Adj, want) {
+		t.Errorf("expected %v; got %v", want, g.Adj)
+	}
+}
+
+func TestReadDigraph(t *testing.T) {
+	g, err := ReadDigraph(openTestData(t, "tinyDG.txt"))
+	if err != nil {
+		t.Fatalf("expected %v; got %v", nil, err)
+	}
+	if g.V != 13 || g.E != 22 {
+		t.Errorf("expected %v and %v; got %v and %v", 13, 22, g.V, g.E)
+	}
+	if !reflect.DeepEqual(g.Adj[6], []int{0, 8, 4, 9}) {
+		t.Errorf("expected %v; got %v", []int{0, 8, 4, 9}, g.Adj[6])
+	}
+	if g.Indegree(4) != 3 {
+		t.Errorf("expected %v; got %v", 3, g.Indegree(4))
+	}
+}
+
+func TestReadEdgeWeightedGraph(t *testing.T) {
+	g, err := ReadEdgeWeightedGraph(openTestData(t, "tinyEWG.txt"))
+	if err != nil {
+		t.Fatalf("expected %v; got %v", nil, err)
+	}
+	if g.V != 8 || g.E != 16 {
+		t.Errorf("expected %v and %v; got %v and %v", 8, 16, g.V, g.E)
+	}
+	want := []Edge{{6, 2, 0.40}, {3, 6, 0.52}, {6, 0, 0.58}, {6, 4, 0.93}}
+	if !reflect.DeepEqual(g.Adj[6], want) {
+		t.Errorf("expected %v; got %v", want, g.Adj[6])
+	}
+}
+
+func TestReadEdgeWeightedDigraph(t *testing.T) {
+	g, err := ReadEdgeWeightedDigraph(openTestData(t, "tinyEWD.txt"))
+	if err != nil {
+		t.Fatalf("expected %v; got %v", nil, err)
+	}
+	if g.V != 8 || g.E != 15 {
+		t.Errorf("expected %v and %v; got %v and %v", 8, 15, g.V, g.E)
+	}
+	want := []DirectedEdge{{6, 2, 0.40}, {6, 0, 0.58}, {6, 4, 0.93}}
+	if !reflect.DeepEqual(g.Adj[6], want) {
+		t.Errorf("expected %v; got %v", want, g.Adj[6])
+	}
+}
+
+func TestReadMalformed(t *testing.T) {
+	testCases := []struct {
+		name     string
+		read     func(io.Reader) error
+		input    string
+		line     int
+		contains string
+	}{
+		{"empty", readGraph, "", 0, "missing number of vertices"},
+		{"bad count", readGraph, "3\nx\n", 2, "invalid number of edges \"x\""},
+		{"negative count", readGraph, "-3\n1\n", 1, "invalid number of vertices"},
+		{"out of range", readGraph, "3\n2\n0 1\n1 3\n", 4, "vertex 3 is not between 0 and 2"},
+		{"truncated", readGraph, "3\n2\n0 1\n", 3, "missing vertex"},
+		{"half an edge", readDigraph, "3\n2\n0 1\n2\n", 4, "missing vertex"},
+		{"self loop", readGraph, "3\n1\n\n1 1\n", 4, "self loop 1-1"},
+		{"trailing", readDigraph, "3\n1\n0 1\n2 0\n", 4, "unexpected \"2\" after 1 edges"},
+		{"bad weight", readEdgeWeightedGraph, "3\n1\n0 1 heavy\n", 3, "invalid weight \"heavy\""},
+		{"NaN weight", readEdgeWeightedDigraph, "3\n1\n0 1 NaN\n", 3, "invalid weight \"NaN\""},
+		{"weighted self loop", readEdgeWeightedGraph, "3\n1\n2 2 0.5\n", 3, "self loop 2-2"},
+		{"too many vertices", readGraph, "100000000000000\n0\n", 1, "number of vertices 100000000000000 is more than MaxReadVertices"},
+		{"too many digraph vertices", readDigraph, "\n16777217\n0\n", 2, "number of vertices 16777217 is more than MaxReadVertices"},
+		{"too many weighted vertices", readEdgeWeightedGraph, "99999999999 0\n", 1, "number of vertices 99999999999 is more than MaxReadVertices"},
+		{"too many weighted digraph vertices", readEdgeWeightedDigraph, "1000000000\n0\n", 1, "number of vertices 1000000000 is more than MaxReadVertices"},
+		{"edges missing", readDigraph, "3\n100000000000\n", 2, "missing vertex"},
+		{"long line", readGraph, "3\n1\n0 " + strings.Repeat(" ", maxLineLength) + "1\n", 3, "token too long"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.read(strings.NewReader(tc.input))
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected a *ParseError; got %v", err)
+			}
+			if pe.Line != tc.line {
+				t.Errorf("expected %v; got %v", tc.line, pe.Line)
+			}
+			if !strings.Contains(err.Error(), tc.contains) {
+				t.Errorf("expected %q to contain %q", err.Error(), tc.contains)
+			}
+		})
+	}
+}
+
+func readGraph(r io.Reader) error {
+	_, err := ReadGraph(r)
+	return err
+}
+
+func readDigraph(r io.Reader) error {
+	_, err := ReadDigraph(r)
+	return err
+}
+
+func readEdgeWeightedGraph(r io.Reader) error {
+	_, err := ReadEdgeWeightedGraph(r)
+	return err
+}
+
+func readEdgeWeightedDigraph(r io.Reader) error {
+	_, err := ReadEdgeWeightedDigraph(r)
+	return err
+}
+
+func TestReadMaxVertices(t *testing.T) {
+	defer func(old int) { MaxReadVertices = old }(MaxReadVertices)
+	input := "20000000\n1\n0 19999999\n"
+	if _, err := ReadGraph(strings.NewReader(input)); err == nil {
+		t.Errorf("expected an error for %v vertices", 20000000)
+	}
+	MaxReadVertices = 20000000
+	g, err := ReadGraph(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.V != 20000000 || !g.HasEdge(0, 19999999) {
+		t.Errorf("expected %v vertices and edge %v-%v; got %v", 20000000, 0, 19999999, g.V)
+	}
+}
+
+func TestReadLongLine(t *testing.T) {
+	// a line well past the bufio.Scanner default of 64KB still parses
+	input := "3\n2\n0 1" + strings.Repeat(" ", 100000) + "1 2\n"
+	g, err := ReadGraph(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if g.E != 2 {
+		t.Errorf("expected %v; got %v", 2, g.E)
+	}
+}
+
+func TestReadTruncatedIsUnexpectedEOF(t *testing.T) {
+	_, err := ReadDigraph(strings.NewReader("3\n2\n0 1\n"))
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("expected %v; got %v", io.ErrUnexpectedEOF, err)
+	}
+}
+
+func TestWriteReadRoundTrip(t *testing.T) {
+	t.Run("Graph", func(t *testing.T) {
+		g, _ := ReadGraph(openTestData(t, "tinyG.txt"))
+		var buf bytes.Buffer
+		if err := WriteGraph(&buf, g); err != nil {
+			t.Fatal(err)
+		}
+		h, err := ReadGraph(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the adjacency lists can come back in a different order
+		for v := 0; v < g.V; v++ {
+			a, b := append([]int{}, g.Adj[v]...), append([]int{}, h.Adj[v]...)
+			sort.Ints(a)
+			sort.Ints(b)
+			if !reflect.DeepEqual(a, b) {
+				t.Errorf("expected %v; got %v", a, b)
+			}
+		}
+	})
+	t.Run("Digraph", func(t *testing.T) {
+		g, _ := ReadDigraph(openTestData(t, "tinyDG.txt"))
+		var buf bytes.Buffer
+		if err := WriteDigraph(&buf, g); err != nil {
+			t.Fatal(err)
+		}
+		h, err := ReadDigraph(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(h, g) {
+			t.Errorf("expected %v; got %v", g.String(), h.String())
+		}
+	})
+	t.Run("EdgeWeightedGraph", func(t *testing.T) {
+		g, _ := ReadEdgeWeightedGraph(openTestData(t, "tinyEWG.txt"))
+		g.AddEdge(Edge{V: 3, W: 5, Weight: 1.0 / 3})
+		var buf bytes.Buffer
+		if err := WriteEdgeWeightedGraph(&buf, g); err != nil {
+			t.Fatal(err)
+		}
+		h, err := ReadEdgeWeightedGraph(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(h.Edges(), g.Edges()) {
+			t.Errorf("expected %v; got %v", g.Edges(), h.Edges())
+		}
+	})
+	t.Run("EdgeWeightedDigraph", func(t *testing.T) {
+		g, _ := ReadEdgeWeightedDigraph(openTestData(t, "tinyEWD.txt"))
+		g.AddEdge(DirectedEdge{From: 3, To: 3, Weight: -1.0 / 3})
+		var buf bytes.Buffer
+		if err := WriteEdgeWeightedDigraph(&buf, g); err != nil {
+			t.Fatal(err)
+		}
+		h, err := ReadEdgeWeightedDigraph(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(h, g) {
+			t.Errorf("expected %v; got %v", g.String(), h.String())
+		}
+	})
+}
+
+func ExampleReadGraph() {
+	g, err := ReadGraph(strings.NewReader("4\n3\n0 1\n1 2\n2 3\n"))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Print(g.String())
+	_, err = ReadGraph(strings.NewReader("4\n3\n0 1\n1 2\n2 4\n"))
+	fmt.Println(err)
+	// Output:
+	// 4 vertices; 3 edges
+	// 0: 1
+	// 1: 0 2
+	// 2: 1 3
+	// 3: 2
+	// line 5: vertex 4 is not between 0 and 3
+}
+
+func ExampleWriteEdgeWeightedDigraph() {
+	g := CreateEdgeWeightedDigraph(3)
+	g.AddEdge(DirectedEdge{0, 1, 0.5})
+	g.AddEdge(DirectedEdge{1, 2, 0.25})
+	WriteEdgeWeightedDigraph(os.Stdout, g)
+	// Output:
+	// 3
+	// 2
+	// 0 1 0.5
+	// 1 2 0.25
+}
@@ -0,0 +1,202 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// The functions in this file read and write graphs in a JSON node-link format,
+// compatible with the one used by NetworkX and D3:
+//
+//	{"directed": false, "nodes": [{"id": 0}, {"id": 1}], "links": [{"source": 0, "target": 1}]}
+//
+// The node ids must be the vertices 0 through V – 1, in any order. Links in
+// weighted graphs have a "weight" as well.
+
+type nodeLinkGraph struct {
+	Directed bool       `json:"directed"`
+	Nodes    []nodeJSON `json:"nodes"`
+	Links    []linkJSON `json:"links"`
+}
+
+type nodeJSON struct {
+	ID int `json:"id"`
+}
+
+type linkJSON struct {
+	Source int      `json:"source"`
+	Target int      `json:"target"`
+	Weight *float64 `json:"weight,omitempty"`
+}
+
+func newNodeLinkGraph(v int, e int, directed bool) nodeLinkGraph {
+	nl := nodeLinkGraph{Directed: directed, Nodes: make([]nodeJSON, v), Links: make([]linkJSON, 0, e)}
+	for x := 0; x < v; x++ {
+		nl.Nodes[x].ID = x
+	}
+	return nl
+}
+
+func writeNodeLink(w io.Writer, nl nodeLinkGraph) error {
+	return json.NewEncoder(w).Encode(nl)
+}
+
+// readNodeLink decodes a node-link graph from r and checks that it has the
+// expected kind, that its nodes are 0 through V – 1, and that its links join
+// valid vertices, have weights if and only if weighted is true, and aren't
+// self loops unless selfLoops is true.
+func readNodeLink(r io.Reader, directed bool, weighted bool, selfLoops bool) (nodeLinkGraph, error) {
+	var nl nodeLinkGraph
+	if err := json.NewDecoder(r).Decode(&nl); err != nil {
+		return nodeLinkGraph{}, err
+	}
+	if nl.Directed != directed {
+		return nodeLinkGraph{}, fmt.Errorf("expected directed to be %v; got %v", directed, nl.Directed)
+	}
+	v := len(nl.Nodes)
+	seen := make([]bool, v)
+	for i, n := range nl.Nodes {
+		if n.ID < 0 || n.ID >= v {
+			return nodeLinkGraph{}, fmt.Errorf("node %v: vertex %v is not between 0 and %v", i, n.ID, v-1)
+		}
+		if seen[n.ID] {
+			return nodeLinkGraph{}, fmt.Errorf("node %v: vertex %v is repeated", i, n.ID)
+		}
+		seen[n.ID] = true
+	}
+	for i, l := range nl.Links {
+		for _, x := range []int{l.Source, l.Target} {
+			if x < 0 || x >= v {
+				return nodeLinkGraph{}, fmt.Errorf("link %v: vertex %v is not between 0 and %v", i, x, v-1)
+			}
+		}
+		if !selfLoops && l.Source == l.Target {
+			return nodeLinkGraph{}, fmt.Errorf("link %v: self loop %v-%v is not allowed", i, l.Source, l.Target)
+		}
+		if weighted && l.Weight == nil {
+			return nodeLinkGraph{}, fmt.Errorf("link %v: missing weight", i)
+		}
+		if !weighted && l.Weight != nil {
+			return nodeLinkGraph{}, fmt.Errorf("link %v: unexpected weight in an unweighted graph", i)
+		}
+	}
+	return nl, nil
+}
+
+// WriteGraphJSON writes g to w in the JSON node-link format, listing each edge
+// once, with its lower-numbered vertex as the source.
+func WriteGraphJSON(w io.Writer, g Graph) error {
+	nl := newNodeLinkGraph(g.V, g.E, false)
+	for v := 0; v < g.V; v++ {
+		for _, x := range g.Adj[v] {
+			if v < x {
+				nl.Links = append(nl.Links, linkJSON{Source: v, Target: x})
+			}
+		}
+	}
+	return writeNodeLink(w, nl)
+}
+
+// ReadGraphJSON reads an undirected graph in the JSON node-link format from r.
+// A repeated link is added only once.
+func ReadGraphJSON(r io.Reader) (Graph, error) {
+	nl, err := readNodeLink(r, false, false, false)
+	if err != nil {
+		return Graph{}, err
+	}
+	g := CreateGraph(len(nl.Nodes))
+	for _, l := range nl.Links {
+		g.AddEdge(l.Source, l.Target)
+	}
+	return g, nil
+}
+
+// WriteDigraphJSON writes g to w in the JSON node-link format.
+func WriteDigraphJSON(w io.Writer, g Digraph) error {
+	nl := newNodeLinkGraph(g.V, g.E, true)
+	for v := 0; v < g.V; v++ {
+		for _, x := range g.Adj[v] {
+			nl.Links = append(nl.Links, linkJSON{Source: v, Target: x})
+		}
+	}
+	return writeNodeLink(w, nl)
+}
+
+// ReadDigraphJSON reads a digraph in the JSON node-link format from r. A
+// repeated link is added only once.
+func ReadDigraphJSON(r io.Reader) (Digraph, error) {
+	nl, err := readNodeLink(r, true, false, true)
+	if err != nil {
+		return Digraph{}, err
+	}
+	g := CreateDigraph(len(nl.Nodes))
+	for _, l := range nl.Links {
+		g.AddEdge(l.Source, l.Target)
+	}
+	return g, nil
+}
+
+// WriteEdgeWeightedGraphJSON writes g to w in the JSON node-link format,
+// listing each edge once.
+func WriteEdgeWeightedGraphJSON(w io.Writer, g EdgeWeightedGraph) error {
+	nl := newNodeLinkGraph(g.V, g.E, false)
+	for _, e := range g.Edges() {
+		weight := e.Weight
+		nl.Links = append(nl.Links, linkJSON{Source: e.V, Target: e.W, Weight: &weight})
+	}
+	return writeNodeLink(w, nl)
+}
+
+// ReadEdgeWeightedGraphJSON reads an edge-weighted graph in the JSON node-link
+// format from r.
+func ReadEdgeWeightedGraphJSON(r io.Reader) (EdgeWeightedGraph, error) {
+	nl, err := readNodeLink(r, false, true, false)
+	if err != nil {
+		return EdgeWeightedGraph{}, err
+	}
+	g := CreateEdgeWeightedGraph(len(nl.Nodes))
+	for _, l := range nl.Links {
+		g.AddEdge(Edge{V: l.Source, W: l.Target, Weight: *l.Weight})
+	}
+	return g, nil
+}
+
+// WriteEdgeWeightedDigraphJSON writes g to w in the JSON node-link format.
+func WriteEdgeWeightedDigraphJSON(w io.Writer, g EdgeWeightedDigraph) error {
+	nl := newNodeLinkGraph(g.V, g.E, true)
+	for _, e := range g.Edges() {
+		weight := e.Weight
+		nl.Links = append(nl.Links, linkJSON{Source: e.From, Target: e.To, Weight: &weight})
+	}
+	return writeNodeLink(w, nl)
+}
+
+// ReadEdgeWeightedDigraphJSON reads an edge-weighted digraph in the JSON
+// node-link format from r.
+func ReadEdgeWeightedDigraphJSON(r io.Reader) (EdgeWeightedDigraph, error) {
+	nl, err := readNodeLink(r, true, true, true)
+	if err != nil {
+		return EdgeWeightedDigraph{}, err
+	}
+	g := CreateEdgeWeightedDigraph(len(nl.Nodes))
+	for _, l := range nl.Links {
+		g.AddEdge(DirectedEdge{From: l.Source, To: l.Target, Weight: *l.Weight})
+	}
+	return g, nil
+}
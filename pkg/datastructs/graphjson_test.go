@@ -0,0 +1,165 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"bytes"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestGraphJSONRoundTrip(t *testing.T) {
+	g, _ := ReadGraph(openTestData(t, "tinyG.txt"))
+	var buf bytes.Buffer
+	if err := WriteGraphJSON(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	h, err := ReadGraphJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the edges come back in the order they were written, which is by lower
+	// vertex, so compare the edge lists rather than the adjacency lists
+	var a, b bytes.Buffer
+	WriteGraph(&a, g)
+	WriteGraph(&b, h)
+	if a.String() != b.String() {
+		t.Errorf("expected %v; got %v", a.String(), b.String())
+	}
+}
+
+func TestDigraphJSONRoundTrip(t *testing.T) {
+	g, _ := ReadDigraph(openTestData(t, "tinyDG.txt"))
+	var buf bytes.Buffer
+	if err := WriteDigraphJSON(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	h, err := ReadDigraphJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h, g) {
+		t.Errorf("expected %v; got %v", g.String(), h.String())
+	}
+}
+
+func TestEdgeWeightedGraphJSONRoundTrip(t *testing.T) {
+	g, _ := ReadEdgeWeightedGraph(openTestData(t, "tinyEWG.txt"))
+	var buf bytes.Buffer
+	if err := WriteEdgeWeightedGraphJSON(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	h, err := ReadEdgeWeightedGraphJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h.Edges(), g.Edges()) {
+		t.Errorf("expected %v; got %v", g.Edges(), h.Edges())
+	}
+}
+
+func TestEdgeWeightedDigraphJSONRoundTrip(t *testing.T) {
+	g, _ := ReadEdgeWeightedDigraph(openTestData(t, "tinyEWD.txt"))
+	g.AddEdge(DirectedEdge{From: 2, To: 2, Weight: 0})
+	var buf bytes.Buffer
+	if err := WriteEdgeWeightedDigraphJSON(&buf, g); err != nil {
+		t.Fatal(err)
+	}
+	h, err := ReadEdgeWeightedDigraphJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(h, g) {
+		t.Errorf("expected %v; got %v", g.String(), h.String())
+	}
+}
+
+func TestReadGraphJSONNodeOrder(t *testing.T) {
+	g, err := ReadGraphJSON(strings.NewReader(`{"nodes": [{"id": 2}, {"id": 0}, {"id": 1}], "links": [{"source": 2, "target": 0}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.V != 3 || g.E != 1 || !reflect.DeepEqual(g.Adj[0], []int{2}) {
+		t.Errorf("expected a graph with edge 2-0; got %v", g.String())
+	}
+}
+
+func TestReadJSONMalformed(t *testing.T) {
+	testCases := []struct {
+		name     string
+		read     func(string) error
+		input    string
+		contains string
+	}{
+		{"syntax", readGraphJSON, `{"nodes": [`, "unexpected EOF"},
+		{"directed", readGraphJSON, `{"directed": true, "nodes": [], "links": []}`, "expected directed to be false"},
+		{"undirected", readDigraphJSON, `{"nodes": [], "links": []}`, "expected directed to be true"},
+		{"node range", readGraphJSON, `{"nodes": [{"id": 0}, {"id": 2}]}`, "node 1: vertex 2 is not between 0 and 1"},
+		{"node repeated", readGraphJSON, `{"nodes": [{"id": 0}, {"id": 0}]}`, "node 1: vertex 0 is repeated"},
+		{"link range", readGraphJSON, `{"nodes": [{"id": 0}, {"id": 1}], "links": [{"source": 0, "target": 1}, {"source": -1, "target": 1}]}`, "link 1: vertex -1 is not between 0 and 1"},
+		{"self loop", readGraphJSON, `{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 0}]}`, "link 0: self loop 0-0"},
+		{"missing weight", readEdgeWeightedDigraphJSON, `{"directed": true, "nodes": [{"id": 0}], "links": [{"source": 0, "target": 0}]}`, "link 0: missing weight"},
+		{"unexpected weight", readDigraphJSON, `{"directed": true, "nodes": [{"id": 0}], "links": [{"source": 0, "target": 0, "weight": 1}]}`, "link 0: unexpected weight"},
+		{"weighted self loop", readEdgeWeightedGraphJSON, `{"nodes": [{"id": 0}], "links": [{"source": 0, "target": 0, "weight": 1}]}`, "link 0: self loop 0-0"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.read(tc.input)
+			if err == nil || !strings.Contains(err.Error(), tc.contains) {
+				t.Errorf("expected an error containing %q; got %v", tc.contains, err)
+			}
+		})
+	}
+}
+
+func readGraphJSON(s string) error {
+	_, err := ReadGraphJSON(strings.NewReader(s))
+	return err
+}
+
+func readDigraphJSON(s string) error {
+	_, err := ReadDigraphJSON(strings.NewReader(s))
+	return err
+}
+
+func readEdgeWeightedGraphJSON(s string) error {
+	_, err := ReadEdgeWeightedGraphJSON(strings.NewReader(s))
+	return err
+}
+
+func readEdgeWeightedDigraphJSON(s string) error {
+	_, err := ReadEdgeWeightedDigraphJSON(strings.NewReader(s))
+	return err
+}
+
+func ExampleWriteGraphJSON() {
+	g := CreateGraph(3)
+	g.AddEdge(0, 1)
+	g.AddEdge(2, 1)
+	WriteGraphJSON(os.Stdout, g)
+	// Output:
+	// {"directed":false,"nodes":[{"id":0},{"id":1},{"id":2}],"links":[{"source":0,"target":1},{"source":1,"target":2}]}
+}
+
+func ExampleWriteEdgeWeightedDigraphJSON() {
+	g := CreateEdgeWeightedDigraph(2)
+	g.AddEdge(DirectedEdge{1, 0, 0.25})
+	WriteEdgeWeightedDigraphJSON(os.Stdout, g)
+	// Output:
+	// {"directed":true,"nodes":[{"id":0},{"id":1}],"links":[{"source":1,"target":0,"weight":0.25}]}
+}
@@ -0,0 +1,183 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import "fmt"
+
+// IndexMinPQ represents an indexed min priority queue of keys. Each key is
+// associated with an integer index between 0 and maxN – 1, which lets clients
+// refer to, and change the priority of, items already on the queue.
+type IndexMinPQ[K Ordered] struct {
+	maxN int
+	n    int   // number of items in the queue
+	pq   []int // binary heap of indices, using 1-based indexing
+	qp   []int // inverse of pq: qp[pq[i]] = pq[qp[i]] = i; -1 if i is not on the queue
+	keys []K   // keys[i] = priority of index i
+}
+
+// NewIndexMinPQ returns an empty indexed priority queue with indices between 0
+// and maxN – 1.
+func NewIndexMinPQ[K Ordered](maxN int) *IndexMinPQ[K] {
+	if maxN < 0 {
+		panic("maxN cannot be negative")
+	}
+	q := IndexMinPQ[K]{maxN: maxN, pq: make([]int, maxN+1), qp: make([]int, maxN+1), keys: make([]K, maxN+1)}
+	for i := 0; i <= maxN; i++ {
+		q.qp[i] = -1
+	}
+	return &q
+}
+
+func (q *IndexMinPQ[K]) validateIndex(i int) {
+	if i < 0 || i >= q.maxN {
+		panic(fmt.Sprintf("index %v is not between 0 and %v", i, q.maxN-1))
+	}
+}
+
+func (q *IndexMinPQ[K]) greater(i int, j int) bool {
+	return q.keys[q.pq[i]] > q.keys[q.pq[j]]
+}
+
+func (q *IndexMinPQ[K]) exch(i int, j int) {
+	q.pq[i], q.pq[j] = q.pq[j], q.pq[i]
+	q.qp[q.pq[i]] = i
+	q.qp[q.pq[j]] = j
+}
+
+func (q *IndexMinPQ[K]) swim(k int) {
+	for k > 1 && q.greater(k/2, k) {
+		q.exch(k, k/2)
+		k = k / 2
+	}
+}
+
+func (q *IndexMinPQ[K]) sink(k int) {
+	for 2*k <= q.n {
+		j := 2 * k
+		if j < q.n && q.greater(j, j+1) {
+			j++
+		}
+		if !q.greater(k, j) {
+			break
+		}
+		q.exch(k, j)
+		k = j
+	}
+}
+
+// IsEmpty returns true if the priority queue is empty; false otherwise.
+func (q *IndexMinPQ[K]) IsEmpty() bool {
+	return q.n == 0
+}
+
+// Size returns the number of keys in the priority queue.
+func (q *IndexMinPQ[K]) Size() int {
+	return q.n
+}
+
+// Contains returns true if i is an index on the priority queue; false otherwise.
+func (q *IndexMinPQ[K]) Contains(i int) bool {
+	q.validateIndex(i)
+	return q.qp[i] != -1
+}
+
+// Insert associates key with index i.
+func (q *IndexMinPQ[K]) Insert(i int, key K) {
+	if q.Contains(i) {
+		panic(fmt.Sprintf("index %v is already in the priority queue", i))
+	}
+	q.n = q.n + 1
+	q.qp[i] = q.n
+	q.pq[q.n] = i
+	q.keys[i] = key
+	q.swim(q.n)
+}
+
+// MinIndex returns an index associated with a minimum key.
+func (q *IndexMinPQ[K]) MinIndex() int {
+	if q.IsEmpty() {
+		panic("cannot return min from an empty queue")
+	}
+	return q.pq[1]
+}
+
+// MinKey returns a minimum key.
+func (q *IndexMinPQ[K]) MinKey() K {
+	if q.IsEmpty() {
+		panic("cannot return min from an empty queue")
+	}
+	return q.keys[q.pq[1]]
+}
+
+// DelMin removes a minimum key and returns its associated index.
+func (q *IndexMinPQ[K]) DelMin() int {
+	if q.IsEmpty() {
+		panic("cannot return min from an empty queue")
+	}
+	min := q.pq[1]
+	q.exch(1, q.n)
+	q.n = q.n - 1
+	q.sink(1)
+	q.qp[min] = -1
+	q.pq[q.n+1] = -1
+	return min
+}
+
+// KeyOf returns the key associated with index i.
+func (q *IndexMinPQ[K]) KeyOf(i int) K {
+	if !q.Contains(i) {
+		panic(fmt.Sprintf("index %v is not in the priority queue", i))
+	}
+	return q.keys[i]
+}
+
+// ChangeKey changes the key associated with index i to the specified value.
+func (q *IndexMinPQ[K]) ChangeKey(i int, key K) {
+	if !q.Contains(i) {
+		panic(fmt.Sprintf("index %v is not in the priority queue", i))
+	}
+	q.keys[i] = key
+	q.swim(q.qp[i])
+	q.sink(q.qp[i])
+}
+
+// DecreaseKey decreases the key associated with index i to the specified value.
+func (q *IndexMinPQ[K]) DecreaseKey(i int, key K) {
+	if !q.Contains(i) {
+		panic(fmt.Sprintf("index %v is not in the priority queue", i))
+	}
+	if key >= q.keys[i] {
+		panic("calling DecreaseKey() with a key that is not strictly less than the current key")
+	}
+	q.keys[i] = key
+	q.swim(q.qp[i])
+}
+
+// Delete removes the key associated with index i.
+func (q *IndexMinPQ[K]) Delete(i int) {
+	if !q.Contains(i) {
+		panic(fmt.Sprintf("index %v is not in the priority queue", i))
+	}
+	index := q.qp[i]
+	q.exch(index, q.n)
+	q.n = q.n - 1
+	// if i was the last item on the heap, there's nothing left to restore
+	if index <= q.n {
+		q.swim(index)
+		q.sink(index)
+	}
+	q.qp[i] = -1
+}
@@ -0,0 +1,115 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestIndexMinPQ(t *testing.T) {
+	q := NewIndexMinPQ[float64](6)
+
+	if q.IsEmpty() != true {
+		t.Errorf("expected %v; got %v", true, q.IsEmpty())
+	}
+
+	keys := []float64{0.5, 0.25, 1.5, 0.75, 2.0, 0.125}
+	for i, k := range keys {
+		q.Insert(i, k)
+	}
+
+	if q.Size() != 6 {
+		t.Errorf("expected %v; got %v", 6, q.Size())
+	}
+	if q.MinIndex() != 5 {
+		t.Errorf("expected %v; got %v", 5, q.MinIndex())
+	}
+	if q.MinKey() != 0.125 {
+		t.Errorf("expected %v; got %v", 0.125, q.MinKey())
+	}
+
+	q.DecreaseKey(4, 0.0625)
+	if q.MinIndex() != 4 {
+		t.Errorf("expected %v; got %v", 4, q.MinIndex())
+	}
+
+	q.ChangeKey(1, 3.0)
+	if q.KeyOf(1) != 3.0 {
+		t.Errorf("expected %v; got %v", 3.0, q.KeyOf(1))
+	}
+
+	q.Delete(0)
+	if q.Contains(0) {
+		t.Errorf("expected %v; got %v", false, q.Contains(0))
+	}
+
+	testCases := []struct {
+		name  string
+		index int
+		size  int
+	}{
+		{"t1", 4, 4},
+		{"t2", 5, 3},
+		{"t3", 3, 2},
+		{"t4", 2, 1},
+		{"t5", 1, 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			i := q.DelMin()
+			s := q.Size()
+			if !((i == tc.index) && (s == tc.size)) {
+				t.Errorf("expected %v and %v; got %v and %v", tc.index, tc.size, i, s)
+			}
+		})
+	}
+}
+
+func TestIndexMinPQDeleteLast(t *testing.T) {
+	q := NewIndexMinPQ[int](3)
+	q.Insert(0, 1)
+	q.Insert(1, 2)
+	q.Insert(2, 3)
+	// index 2 sits at the bottom of the heap; deleting it must not disturb the rest
+	q.Delete(2)
+	if q.Size() != 2 {
+		t.Errorf("expected %v; got %v", 2, q.Size())
+	}
+	if i := q.DelMin(); i != 0 {
+		t.Errorf("expected %v; got %v", 0, i)
+	}
+	if i := q.DelMin(); i != 1 {
+		t.Errorf("expected %v; got %v", 1, i)
+	}
+}
+
+func ExampleIndexMinPQ() {
+	q := NewIndexMinPQ[string](4)
+	q.Insert(0, "it")
+	q.Insert(1, "was")
+	q.Insert(2, "the")
+	q.Insert(3, "best")
+	for !q.IsEmpty() {
+		i := q.DelMin()
+		fmt.Println(i)
+	}
+	// Output:
+	// 3
+	// 0
+	// 2
+	// 1
+}
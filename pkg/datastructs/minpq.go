@@ -0,0 +1,102 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+// MinPQ represents a min priority queue of generic items. It's implemented with
+// a binary heap, ordered by the less function passed to NewMinPQ.
+type MinPQ[T any] struct {
+	pq   []T // store items at indices 1 to n
+	n    int // number of items in the queue
+	less func(a T, b T) bool
+}
+
+// NewMinPQ returns an empty min priority queue that orders its items with less.
+func NewMinPQ[T any](less func(a T, b T) bool) *MinPQ[T] {
+	if less == nil {
+		panic("less function must not be nil")
+	}
+	// add a placeholder at index 0, for indexing at 1
+	return &MinPQ[T]{pq: make([]T, 1), less: less}
+}
+
+func (q *MinPQ[T]) greater(i int, j int) bool {
+	return q.less(q.pq[j], q.pq[i])
+}
+
+func (q *MinPQ[T]) exch(i int, j int) {
+	q.pq[i], q.pq[j] = q.pq[j], q.pq[i]
+}
+
+func (q *MinPQ[T]) swim(k int) {
+	for k > 1 && q.greater(k/2, k) {
+		q.exch(k, k/2)
+		k = k / 2
+	}
+}
+
+func (q *MinPQ[T]) sink(k int) {
+	for 2*k <= q.n {
+		j := 2 * k
+		if j < q.n && q.greater(j, j+1) {
+			j++
+		}
+		if !q.greater(k, j) {
+			break
+		}
+		q.exch(k, j)
+		k = j
+	}
+}
+
+// IsEmpty returns true if the priority queue is empty; false otherwise.
+func (q *MinPQ[T]) IsEmpty() bool {
+	return q.n == 0
+}
+
+// Size returns the number of items in the priority queue.
+func (q *MinPQ[T]) Size() int {
+	return q.n
+}
+
+// Insert adds an item to the priority queue.
+func (q *MinPQ[T]) Insert(x T) {
+	q.pq = append(q.pq, x)
+	q.n = q.n + 1
+	q.swim(q.n)
+}
+
+// Min returns the smallest item in the priority queue.
+func (q *MinPQ[T]) Min() T {
+	if q.IsEmpty() {
+		panic("cannot return min from an empty queue")
+	}
+	return q.pq[1]
+}
+
+// DelMin removes and returns the smallest item in the priority queue.
+func (q *MinPQ[T]) DelMin() T {
+	if q.IsEmpty() {
+		panic("cannot return min from an empty queue")
+	}
+	min := q.pq[1]
+	q.exch(1, q.n)
+	q.n = q.n - 1
+	q.sink(1)
+	var zero T
+	q.pq[q.n+1] = zero // avoid loitering
+	q.pq = q.pq[:q.n+1]
+	return min
+}
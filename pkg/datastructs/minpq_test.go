@@ -0,0 +1,94 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMinPQ(t *testing.T) {
+	q := NewMinPQ(func(a, b int) bool { return a < b })
+
+	if q.IsEmpty() != true {
+		t.Errorf("expected %v; got %v", true, q.IsEmpty())
+	}
+
+	if q.Size() != 0 {
+		t.Errorf("expected %v; got %v", 0, q.Size())
+	}
+
+	a := []int{8, 1, 89, 2, 21, 3, 0, 1, 55, 5, 13, 34}
+	for _, n := range a {
+		q.Insert(n)
+	}
+
+	if q.Size() != 12 {
+		t.Errorf("expected %v; got %v", 12, q.Size())
+	}
+
+	if q.Min() != 0 {
+		t.Errorf("expected %v; got %v", 0, q.Min())
+	}
+
+	testCases := []struct {
+		name string
+		min  int
+		size int
+	}{
+		{"t1", 0, 11},
+		{"t2", 1, 10},
+		{"t3", 1, 9},
+		{"t4", 2, 8},
+		{"t5", 3, 7},
+		{"t6", 5, 6},
+		{"t7", 8, 5},
+		{"t8", 13, 4},
+		{"t9", 21, 3},
+		{"t10", 34, 2},
+		{"t11", 55, 1},
+		{"t12", 89, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := q.DelMin()
+			s := q.Size()
+			if !((m == tc.min) && (s == tc.size)) {
+				t.Errorf("expected %v and %v; got %v and %v", tc.min, tc.size, m, s)
+			}
+		})
+	}
+
+	if q.IsEmpty() != true {
+		t.Errorf("expected %v; got %v", true, q.IsEmpty())
+	}
+}
+
+func ExampleMinPQ() {
+	// order edges by weight
+	q := NewMinPQ(func(a, b Edge) bool { return a.Weight < b.Weight })
+	q.Insert(Edge{0, 1, 0.5})
+	q.Insert(Edge{1, 2, 0.25})
+	q.Insert(Edge{2, 0, 1.5})
+	for !q.IsEmpty() {
+		fmt.Println(q.DelMin())
+	}
+	// Output:
+	// 1-2 0.25000
+	// 0-1 0.50000
+	// 2-0 1.50000
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// SymbolGraph represents an undirected graph whose vertices are named by
+// strings. It assigns the names the integer vertices 0 through V – 1 of an
+// underlying Graph, in the order in which they first appear.
+type SymbolGraph struct {
+	st    map[string]int // st[name] = index of name
+	keys  []string       // keys[v] = name of vertex v
+	graph Graph
+}
+
+// NewSymbolGraph builds a symbol graph from r, which has one line per vertex:
+// the vertex's name followed by the names of its neighbours, all separated by
+// delimiter, such as " " for algs4 routes.txt or "/" for movies.txt.
+// Whitespace around each name is ignored, as are blank lines. If delimiter is
+// only whitespace, names are separated by any run of whitespace. A line that
+// connects a vertex to itself or has an empty name is an error, reported as a
+// *ParseError, as is a line longer than 16MB.
+func NewSymbolGraph(r io.Reader, delimiter string) (*SymbolGraph, error) {
+	if delimiter == "" {
+		return nil, fmt.Errorf("delimiter must not be empty")
+	}
+	sg := &SymbolGraph{st: map[string]int{}}
+	var edges [][2]int
+	sc := newLineScanner(r)
+	line := 0
+	for sc.Scan() {
+		line = line + 1
+		if strings.TrimSpace(sc.Text()) == "" {
+			continue
+		}
+		var fields []string
+		if strings.TrimSpace(delimiter) == "" {
+			fields = strings.Fields(sc.Text())
+		} else {
+			fields = strings.Split(sc.Text(), delimiter)
+		}
+		vertices := make([]int, len(fields))
+		for i, f := range fields {
+			name := strings.TrimSpace(f)
+			if name == "" {
+				return nil, &ParseError{Line: line, Err: fmt.Errorf("name %v is empty", i+1)}
+			}
+			v, ok := sg.st[name]
+			if !ok {
+				v = len(sg.keys)
+				sg.st[name] = v
+				sg.keys = append(sg.keys, name)
+			}
+			vertices[i] = v
+		}
+		for _, w := range vertices[1:] {
+			if w == vertices[0] {
+				return nil, &ParseError{Line: line, Err: fmt.Errorf("self loop %q is not allowed", sg.keys[w])}
+			}
+			edges = append(edges, [2]int{vertices[0], w})
+		}
+	}
+	if err := sc.Err(); err != nil {
+		// the error is in the line after the last one scanned
+		return nil, &ParseError{Line: line + 1, Err: err}
+	}
+
+	sg.graph = CreateGraph(len(sg.keys))
+	for _, e := range edges {
+		sg.graph.AddEdge(e[0], e[1])
+	}
+	return sg, nil
+}
+
+// Contains returns true if the graph has a vertex named name.
+func (sg *SymbolGraph) Contains(name string) bool {
+	_, ok := sg.st[name]
+	return ok
+}
+
+// Index returns the vertex named name, or -1 if there is no such vertex.
+func (sg *SymbolGraph) Index(name string) int {
+	v, ok := sg.st[name]
+	if !ok {
+		return -1
+	}
+	return v
+}
+
+// Name returns the name of vertex v.
+func (sg *SymbolGraph) Name(v int) string {
+	sg.graph.validateVertex(v)
+	return sg.keys[v]
+}
+
+// Graph returns the underlying graph. It shares its adjacency lists with the
+// symbol graph, so it must not be modified.
+func (sg *SymbolGraph) Graph() Graph {
+	return sg.graph
+}
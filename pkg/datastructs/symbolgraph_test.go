@@ -0,0 +1,180 @@
+// Copyright 2022 Google LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with this program. If not, see <http://www.gnu.org/licenses/>.
+
+package datastructs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSymbolGraph(t *testing.T) {
+	sg, err := NewSymbolGraph(openTestData(t, "routes.txt"), " ")
+	if err != nil {
+		t.Fatalf("expected %v; got %v", nil, err)
+	}
+	g := sg.Graph()
+	if g.V != 10 || g.E != 18 {
+		t.Errorf("expected %v and %v; got %v and %v", 10, 18, g.V, g.E)
+	}
+
+	testCases := []struct {
+		name      string
+		index     int
+		neighbors []string
+	}{
+		{"JFK", 0, []string{"MCO", "ATL", "ORD"}},
+		{"ORD", 2, []string{"DEN", "HOU", "DFW", "PHX", "JFK", "ATL"}},
+		{"LAS", 9, []string{"DEN", "LAX", "PHX"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if !sg.Contains(tc.name) {
+				t.Errorf("expected %v; got %v", true, sg.Contains(tc.name))
+			}
+			if sg.Index(tc.name) != tc.index {
+				t.Errorf("expected %v; got %v", tc.index, sg.Index(tc.name))
+			}
+			if sg.Name(tc.index) != tc.name {
+				t.Errorf("expected %v; got %v", tc.name, sg.Name(tc.index))
+			}
+			var neighbors []string
+			for _, w := range g.Adj[tc.index] {
+				neighbors = append(neighbors, sg.Name(w))
+			}
+			if strings.Join(neighbors, " ") != strings.Join(tc.neighbors, " ") {
+				t.Errorf("expected %v; got %v", tc.neighbors, neighbors)
+			}
+		})
+	}
+
+	if sg.Contains("SFO") {
+		t.Errorf("expected %v; got %v", false, sg.Contains("SFO"))
+	}
+	if sg.Index("SFO") != -1 {
+		t.Errorf("expected %v; got %v", -1, sg.Index("SFO"))
+	}
+}
+
+func TestSymbolGraphNamePanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected Name to panic on an invalid vertex")
+		}
+	}()
+	sg, _ := NewSymbolGraph(strings.NewReader("a b\n"), " ")
+	sg.Name(2)
+}
+
+func TestSymbolGraphMalformed(t *testing.T) {
+	testCases := []struct {
+		name     string
+		input    string
+		line     int
+		contains string
+	}{
+		{"empty name", "a/b\n\nc//d\n", 3, "name 2 is empty"},
+		{"self loop", "a/b\nb/c/b\n", 2, "self loop \"b\""},
+		{"trailing delimiter", "a/b/\n", 1, "name 3 is empty"},
+		{"long line", "a/b\n" + strings.Repeat("c", maxLineLength+1) + "\n", 2, "token too long"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewSymbolGraph(strings.NewReader(tc.input), "/")
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected a *ParseError; got %v", err)
+			}
+			if pe.Line != tc.line {
+				t.Errorf("expected %v; got %v", tc.line, pe.Line)
+			}
+			if !strings.Contains(err.Error(), tc.contains) {
+				t.Errorf("expected %q to contain %q", err.Error(), tc.contains)
+			}
+		})
+	}
+	if _, err := NewSymbolGraph(strings.NewReader("a b\n"), ""); err == nil {
+		t.Errorf("expected an error for an empty delimiter")
+	}
+}
+
+func TestSymbolGraphWhitespaceDelimiter(t *testing.T) {
+	testCases := []struct {
+		name  string
+		input string
+	}{
+		{"single", "JFK MCO ATL\n"},
+		{"trailing delimiter", "JFK MCO ATL \n"},
+		{"doubled delimiter", "JFK  MCO ATL\n"},
+		{"tab", "JFK\tMCO ATL\n"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sg, err := NewSymbolGraph(strings.NewReader(tc.input), " ")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			want := []string{"JFK", "MCO", "ATL"}
+			for v, name := range want {
+				if sg.Index(name) != v {
+					t.Errorf("expected %v; got %v", v, sg.Index(name))
+				}
+			}
+			if g := sg.Graph(); g.V != 3 || g.E != 2 {
+				t.Errorf("expected %v and %v; got %v and %v", 3, 2, g.V, g.E)
+			}
+		})
+	}
+}
+
+func TestSymbolGraphLongLine(t *testing.T) {
+	// a movie with a cast long enough to pass the bufio.Scanner default of 64KB
+	var sb strings.Builder
+	sb.WriteString("Epic (2000)")
+	for i := 0; i < 5000; i++ {
+		fmt.Fprintf(&sb, "/Actor, Number %v", i)
+	}
+	sb.WriteString("\n")
+	sg, err := NewSymbolGraph(strings.NewReader(sb.String()), "/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	g := sg.Graph()
+	if g.Degree(sg.Index("Epic (2000)")) != 5000 {
+		t.Errorf("expected %v; got %v", 5000, g.Degree(sg.Index("Epic (2000)")))
+	}
+}
+
+func ExampleSymbolGraph() {
+	// a movie followed by its cast, as in algs4 movies.txt
+	movies := `Animal House (1978)/Bacon, Kevin/Sutherland, Donald
+Apollo 13 (1995)/Bacon, Kevin/Hanks, Tom/Paxton, Bill
+Big (1988)/Hanks, Tom/Perkins, Elizabeth
+`
+	sg, err := NewSymbolGraph(strings.NewReader(movies), "/")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	g := sg.Graph()
+	for _, v := range g.Adj[sg.Index("Hanks, Tom")] {
+		fmt.Println(sg.Name(v))
+	}
+	// Output:
+	// Apollo 13 (1995)
+	// Big (1988)
+}